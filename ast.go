@@ -15,6 +15,7 @@
 package thriftcheck
 
 import (
+	"errors"
 	"fmt"
 	"path/filepath"
 	"reflect"
@@ -48,34 +49,78 @@ func Doc(node ast.Node) string {
 	return ""
 }
 
+// IncludeName returns the name that qualifies references to an included file's
+// definitions: the include's explicit name, if it has one, or otherwise the
+// file's base name without its ".thrift" extension.
+func IncludeName(include *ast.Include) string {
+	if include.Name != "" {
+		return include.Name
+	}
+	return strings.TrimSuffix(filepath.Base(include.Path), ".thrift")
+}
+
+// Errors returned when a reference can't be resolved. They are wrapped by more
+// specific errors, so use [errors.Is] to test for them.
+var (
+	// ErrIncludeMissing means that the reference refers to an included file
+	// but there isn't a matching `include`.
+	ErrIncludeMissing = errors.New("missing include")
+
+	// ErrIncludeNotFound means that the included file couldn't be found.
+	ErrIncludeNotFound = errors.New("included file not found")
+
+	// ErrUndefined means that the name isn't defined in the program or in the
+	// included file.
+	ErrUndefined = errors.New("undefined")
+)
+
+// resolveError wraps one of the resolution errors with a specific message.
+type resolveError struct {
+	err     error
+	message string
+}
+
+func (e *resolveError) Error() string { return e.message }
+func (e *resolveError) Unwrap() error { return e.err }
+
 // Resolve resolves a named reference to its target node.
 //
 // The target can either be in the current program's scope or it can refer to
 // an included file using dot notation. Included files must exist in one of the
 // given search directories.
 func Resolve(name string, program *ast.Program, parser *FileParser) (ast.Node, error) {
+	n, _, err := resolve(name, program, parser, "")
+	return n, err
+}
+
+// resolve resolves a named reference. Included files are located relative to
+// dir and then the parser's search directories. If the target is defined in
+// an included file, that file's path is also returned.
+func resolve(name string, program *ast.Program, parser *FileParser, dir string) (ast.Node, string, error) {
 	defs := program.Definitions
+	var path string
 
 	if strings.Contains(name, ".") {
 		parts := strings.SplitN(name, ".", 2)
-		fname := parts[0] + ".thrift"
 
 		var ipath string
 		for _, header := range program.Headers {
-			if include, ok := header.(*ast.Include); ok {
-				if _, file := filepath.Split(include.Path); file == fname {
-					ipath = include.Path
-					break
-				}
+			if include, ok := header.(*ast.Include); ok && IncludeName(include) == parts[0] {
+				ipath = include.Path
+				break
 			}
 		}
 		if ipath == "" {
-			return nil, fmt.Errorf("missing \"include\" for type reference %q", name)
+			return nil, "", &resolveError{ErrIncludeMissing, fmt.Sprintf("missing \"include\" for type reference %q", name)}
 		}
 
-		program, _, err := parser.ParseFile(ipath)
+		var err error
+		if path, err = parser.Locate(ipath, dir); err != nil {
+			return nil, "", &resolveError{ErrIncludeNotFound, err.Error()}
+		}
+		program, _, err := parser.ParseFile(path)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 
 		defs = program.Definitions
@@ -84,11 +129,11 @@ func Resolve(name string, program *ast.Program, parser *FileParser) (ast.Node, e
 
 	for _, def := range defs {
 		if def.Info().Name == name {
-			return def, nil
+			return def, path, nil
 		}
 	}
 
-	return nil, fmt.Errorf("%q could not be resolved", name)
+	return nil, "", &resolveError{ErrUndefined, fmt.Sprintf("%q could not be resolved", name)}
 }
 
 // ResolveConstant resolves an [ast.ConstantReference] to its target node.
@@ -99,26 +144,31 @@ func Resolve(name string, program *ast.Program, parser *FileParser) (ast.Node, e
 //   - "include.Constant" (ast.Constant)
 //   - "include.Enum.Value" (ast.EnumItem)
 func ResolveConstant(ref ast.ConstantReference, program *ast.Program, parser *FileParser) (ast.Node, error) {
+	n, _, err := resolveConstant(ref, program, parser, "")
+	return n, err
+}
+
+func resolveConstant(ref ast.ConstantReference, program *ast.Program, parser *FileParser, dir string) (ast.Node, string, error) {
 	parts := strings.SplitN(ref.Name, ".", 3)
 
-	n, err := Resolve(parts[0], program, parser)
+	n, path, err := resolve(parts[0], program, parser, dir)
 	if err != nil && len(parts) > 1 {
-		n, err = Resolve(parts[0]+"."+parts[1], program, parser)
+		n, path, err = resolve(parts[0]+"."+parts[1], program, parser, dir)
 	}
 	if err != nil {
-		return n, fmt.Errorf("%q could not be resolved", ref.Name)
+		return n, "", fmt.Errorf("%q could not be resolved", ref.Name)
 	}
 
 	if e, ok := n.(*ast.Enum); ok {
 		for _, ei := range e.Items {
 			if ei.Name == parts[len(parts)-1] {
-				return ei, nil
+				return ei, path, nil
 			}
 		}
-		return nil, fmt.Errorf("enum value %q could not be resolved", ref.Name)
+		return nil, "", fmt.Errorf("enum value %q could not be resolved", ref.Name)
 	}
 
-	return n, nil
+	return n, path, nil
 }
 
 // ResolveType calls Resolve and goes one step further by attempting to
@@ -126,19 +176,24 @@ func ResolveConstant(ref ast.ConstantReference, program *ast.Program, parser *Fi
 // points to an [ast.Typedef] or [ast.Constant], for example, and the caller
 // is primarily intererested in the target's ast.Type.
 func ResolveType(ref ast.TypeReference, program *ast.Program, parser *FileParser) (ast.Node, error) {
-	n, err := Resolve(ref.Name, program, parser)
+	n, _, err := resolveType(ref, program, parser, "")
+	return n, err
+}
+
+func resolveType(ref ast.TypeReference, program *ast.Program, parser *FileParser, dir string) (ast.Node, string, error) {
+	n, path, err := resolve(ref.Name, program, parser, dir)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	switch t := n.(type) {
 	case *ast.Constant:
-		return t.Type, nil
+		return t.Type, path, nil
 
 	case *ast.Typedef:
-		return t.Type, nil
+		return t.Type, path, nil
 
 	default:
-		return n, nil
+		return n, path, nil
 	}
 }
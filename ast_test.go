@@ -15,6 +15,9 @@
 package thriftcheck
 
 import (
+	"errors"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -39,6 +42,24 @@ func TestDoc(t *testing.T) {
 	}
 }
 
+func TestIncludeName(t *testing.T) {
+	tests := []struct {
+		include *ast.Include
+		want    string
+	}{
+		{&ast.Include{Path: "types.thrift"}, "types"},
+		{&ast.Include{Path: "shared/types.thrift"}, "types"},
+		{&ast.Include{Path: "../shared/types.thrift"}, "types"},
+		{&ast.Include{Path: "shared/types.thrift", Name: "t"}, "t"},
+	}
+
+	for _, tt := range tests {
+		if got := IncludeName(tt.include); got != tt.want {
+			t.Errorf("expected %q but got %q for %#v", tt.want, got, tt.include)
+		}
+	}
+}
+
 func TestResolveConstant(t *testing.T) {
 	tests := []struct {
 		ref  ast.ConstantReference
@@ -87,3 +108,40 @@ func TestResolveConstant(t *testing.T) {
 		}
 	}
 }
+
+func TestResolveErrors(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "shared.thrift"), []byte(`struct Shared {}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	prog := &ast.Program{
+		Headers: []ast.Header{
+			&ast.Include{Path: "shared.thrift"},
+			&ast.Include{Path: "missing.thrift"},
+		},
+		Definitions: []ast.Definition{
+			&ast.Struct{Name: "Local"},
+		},
+	}
+	parser := NewFileParser([]string{dir})
+
+	tests := []struct {
+		name string
+		want error
+	}{
+		{"Local", nil},
+		{"shared.Shared", nil},
+		{"Unknown", ErrUndefined},
+		{"shared.Unknown", ErrUndefined},
+		{"other.Other", ErrIncludeMissing},
+		{"missing.Missing", ErrIncludeNotFound},
+	}
+
+	for _, tt := range tests {
+		_, err := Resolve(tt.name, prog, parser)
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, err)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2025 Pinterest
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thriftcheck
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+)
+
+// Fingerprint returns a value that identifies the message independently of
+// its position in the file. It is derived from the kind of node the message
+// refers to, the name of its enclosing definition, and the message text, so it
+// is unaffected by unrelated edits that move the node to a different line.
+func (m Message) Fingerprint() string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00%s\x00%s", nodeKind(m.Node), m.Definition, m.Message)
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+// Baseline is a set of previously reported messages. It is used to suppress
+// existing messages so that only newly introduced messages are reported.
+//
+// Messages are matched by filename, check, and fingerprint. A baseline entry
+// counts how many matching messages were recorded, so adding another message
+// that is identical to an existing one is still reported.
+type Baseline struct {
+	counts map[baselineKey]int
+}
+
+type baselineKey struct {
+	Filename    string `json:"filename"`
+	Check       string `json:"check"`
+	Fingerprint string `json:"fingerprint"`
+}
+
+type baselineEntry struct {
+	baselineKey
+	Count int `json:"count"`
+}
+
+type baselineFile struct {
+	Version  int             `json:"version"`
+	Messages []baselineEntry `json:"messages"`
+}
+
+const baselineVersion = 1
+
+// NewBaseline returns a Baseline that contains all of the given messages.
+func NewBaseline(msgs Messages) *Baseline {
+	b := &Baseline{counts: make(map[baselineKey]int, len(msgs))}
+	for _, m := range msgs {
+		b.counts[newBaselineKey(m)]++
+	}
+	return b
+}
+
+// ReadBaseline reads a Baseline previously written by [Baseline.Write].
+func ReadBaseline(r io.Reader) (*Baseline, error) {
+	var f baselineFile
+	if err := json.NewDecoder(r).Decode(&f); err != nil {
+		return nil, fmt.Errorf("invalid baseline: %w", err)
+	}
+	if f.Version != baselineVersion {
+		return nil, fmt.Errorf("unsupported baseline version %d", f.Version)
+	}
+
+	b := &Baseline{counts: make(map[baselineKey]int, len(f.Messages))}
+	for _, e := range f.Messages {
+		b.counts[e.baselineKey] += e.Count
+	}
+	return b, nil
+}
+
+// Write writes the baseline to w as JSON. Entries are sorted so that the
+// output is stable and suitable for version control.
+func (b *Baseline) Write(w io.Writer) error {
+	f := baselineFile{Version: baselineVersion, Messages: make([]baselineEntry, 0, len(b.counts))}
+	for key, count := range b.counts {
+		f.Messages = append(f.Messages, baselineEntry{baselineKey: key, Count: count})
+	}
+	slices.SortFunc(f.Messages, func(a, b baselineEntry) int {
+		return cmp.Or(
+			cmp.Compare(a.Filename, b.Filename),
+			cmp.Compare(a.Check, b.Check),
+			cmp.Compare(a.Fingerprint, b.Fingerprint))
+	})
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(f)
+}
+
+// Filter returns the messages that aren't part of the baseline.
+func (b *Baseline) Filter(msgs Messages) Messages {
+	remaining := make(map[baselineKey]int, len(b.counts))
+	for key, count := range b.counts {
+		remaining[key] = count
+	}
+
+	filtered := Messages{}
+	for _, m := range msgs {
+		key := newBaselineKey(m)
+		if remaining[key] > 0 {
+			remaining[key]--
+			continue
+		}
+		filtered = append(filtered, m)
+	}
+	return filtered
+}
+
+func newBaselineKey(m Message) baselineKey {
+	return baselineKey{
+		Filename:    filepath.ToSlash(m.Filename),
+		Check:       m.Check,
+		Fingerprint: m.Fingerprint(),
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2025 Pinterest
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thriftcheck
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"go.uber.org/thriftrw/ast"
+)
+
+func TestMessageFingerprint(t *testing.T) {
+	m := Message{Filename: "a.thrift", Pos: ast.Position{Line: 5}, Node: &ast.Field{}, Check: "check", Message: "message"}
+
+	moved := m
+	moved.Pos = ast.Position{Line: 10, Column: 3}
+	if m.Fingerprint() != moved.Fingerprint() {
+		t.Errorf("expected fingerprint to be independent of position")
+	}
+
+	for _, other := range []Message{
+		{Filename: "a.thrift", Node: &ast.Struct{}, Check: "check", Message: "message"},
+		{Filename: "a.thrift", Node: &ast.Field{}, Check: "check", Message: "other message"},
+		{Filename: "a.thrift", Node: &ast.Field{}, Definition: "S", Check: "check", Message: "message"},
+	} {
+		if m.Fingerprint() == other.Fingerprint() {
+			t.Errorf("expected %v and %v fingerprints to differ", m, other)
+		}
+	}
+}
+
+func TestBaselineFilter(t *testing.T) {
+	existing := Messages{
+		{Filename: "a.thrift", Pos: ast.Position{Line: 1}, Node: &ast.Field{}, Check: "check", Message: "one"},
+		{Filename: "a.thrift", Pos: ast.Position{Line: 2}, Node: &ast.Field{}, Check: "check", Message: "one"},
+		{Filename: "b.thrift", Pos: ast.Position{Line: 3}, Node: &ast.Field{}, Check: "check", Message: "two"},
+	}
+	baseline := NewBaseline(existing)
+
+	added := Message{Filename: "a.thrift", Pos: ast.Position{Line: 9}, Node: &ast.Field{}, Check: "check", Message: "one"}
+	other := Message{Filename: "b.thrift", Pos: ast.Position{Line: 3}, Node: &ast.Field{}, Check: "other", Message: "two"}
+
+	tests := []struct {
+		desc string
+		msgs Messages
+		want Messages
+	}{
+		{"unchanged", existing, Messages{}},
+		{"moved", Messages{added, existing[2]}, Messages{}},
+		{"duplicate", append(Messages{added}, existing...), Messages{existing[1]}},
+		{"other check", Messages{other}, Messages{other}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			if got := baseline.Filter(tt.msgs); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestBaselineReadWrite(t *testing.T) {
+	baseline := NewBaseline(Messages{
+		{Filename: "b.thrift", Node: &ast.Field{}, Check: "check", Message: "two"},
+		{Filename: "a.thrift", Node: &ast.Field{}, Check: "check", Message: "one"},
+		{Filename: "a.thrift", Node: &ast.Field{}, Check: "check", Message: "one"},
+	})
+
+	var buf bytes.Buffer
+	if err := baseline.Write(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if i, j := strings.Index(buf.String(), "a.thrift"), strings.Index(buf.String(), "b.thrift"); i > j {
+		t.Errorf("expected sorted entries:\n%s", buf.String())
+	}
+
+	read, err := ReadBaseline(&buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(read, baseline) {
+		t.Errorf("expected %v, got %v", baseline, read)
+	}
+}
+
+func TestReadBaselineErrors(t *testing.T) {
+	for _, s := range []string{``, `[]`, `{"version": 0, "messages": []}`} {
+		if _, err := ReadBaseline(strings.NewReader(s)); err == nil {
+			t.Errorf("%q: expected an error", s)
+		}
+	}
+}
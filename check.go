@@ -17,6 +17,7 @@ package thriftcheck
 import (
 	"fmt"
 	"log"
+	"path/filepath"
 	"reflect"
 	"sort"
 	"strings"
@@ -27,8 +28,10 @@ import (
 
 // Check is a named check function.
 type Check struct {
-	Name string
-	fn   any
+	Name        string
+	Description string
+	fn          any
+	project     func(*P)
 }
 
 // Checks is a list of checks.
@@ -59,6 +62,23 @@ func NewCheck(name string, fn any) Check {
 	return Check{Name: name, fn: fn}
 }
 
+// NewProjectCheck creates a new project Check. Project checks run once per
+// call to [Linter.LintFiles], after each file has been linted, and can reason
+// about all of the linted files and the files they include.
+func NewProjectCheck(name string, fn func(*P)) Check {
+	if fn == nil {
+		panic("project check function must not be nil")
+	}
+	return Check{Name: name, project: fn}
+}
+
+// WithDescription returns a copy of the check with the given human-readable
+// description, which is used by reporters that describe their rules.
+func (c Check) WithDescription(description string) Check {
+	c.Description = description
+	return c
+}
+
 // Call the check function if its arguments end with the current node in the
 // hierarchy and all other variable arguments are its strictly ordered parents.
 //
@@ -90,6 +110,9 @@ func (c *Check) Call(ctx *C, nodes ...ast.Node) bool {
 	if len(nodes) < 1 {
 		panic("expected at least one node")
 	}
+	if c.fn == nil {
+		return false
+	}
 
 	f := reflect.TypeOf(c.fn)
 
@@ -120,6 +143,22 @@ func (c *Check) Call(ctx *C, nodes ...ast.Node) bool {
 	return true
 }
 
+// CallProject calls the check function if this is a project check.
+func (c *Check) CallProject(p *P) bool {
+	if c.project == nil {
+		return false
+	}
+
+	p.Check = c.Name
+	c.project(p)
+	return true
+}
+
+// IsProject reports whether this is a project check.
+func (c *Check) IsProject() bool {
+	return c.project != nil
+}
+
 func (c Checks) String() string {
 	return strings.Join(c.SortedNames(), " ")
 }
@@ -164,15 +203,21 @@ next:
 }
 
 // C is a type passed to all check functions to provide context.
+//
+// Previous is the previous revision of Program, which is only available when
+// the Linter is configured using the WithPrevious option and the file existed
+// in that revision.
 type C struct {
 	Filename  string
 	Dirs      []string
 	Program   *ast.Program
+	Previous  *ast.Program
 	Check     string
 	Messages  Messages
 	logger    *log.Logger
 	parser    *FileParser
 	parseInfo *idl.Info
+	nodes     []ast.Node // the current node through its ancestors
 }
 
 func (c *C) pos(n ast.Node) ast.Position {
@@ -190,40 +235,123 @@ func (c *C) Logf(message string, args ...any) {
 	}
 }
 
-// Warningf records a new message for the given node with Warning severity.
-func (c *C) Warningf(node ast.Node, message string, args ...any) {
-	m := Message{Filename: c.Filename, Pos: c.pos(node), Node: node, Check: c.Check, Severity: Warning, Message: fmt.Sprintf(message, args...)}
+// MessageRef refers to a Message recorded by a check function. It can be used
+// to attach additional information to the message.
+type MessageRef struct {
+	msgs *Messages
+	i    int
+}
+
+// WithFix attaches a fix, made up of the given edits, to the message.
+func (r MessageRef) WithFix(edits ...Edit) MessageRef {
+	(*r.msgs)[r.i].Fix = append((*r.msgs)[r.i].Fix, edits...)
+	return r
+}
+
+func (c *C) reportf(node ast.Node, severity Severity, message string, args ...any) MessageRef {
+	m := Message{Filename: c.Filename, Pos: c.pos(node), Node: node, Definition: definitionName(c.nodes), Check: c.Check, Severity: severity, Message: fmt.Sprintf(message, args...)}
 	c.Messages = append(c.Messages, m)
+	return MessageRef{msgs: &c.Messages, i: len(c.Messages) - 1}
+}
+
+// Warningf records a new message for the given node with Warning severity.
+func (c *C) Warningf(node ast.Node, message string, args ...any) MessageRef {
+	return c.reportf(node, Warning, message, args...)
 }
 
 // Errorf records a new message for the given node with Error severity.
-func (c *C) Errorf(node ast.Node, message string, args ...any) {
-	m := Message{Filename: c.Filename, Pos: c.pos(node), Node: node, Check: c.Check, Severity: Error, Message: fmt.Sprintf(message, args...)}
-	c.Messages = append(c.Messages, m)
+func (c *C) Errorf(node ast.Node, message string, args ...any) MessageRef {
+	return c.reportf(node, Error, message, args...)
+}
+
+// InsertBefore returns an Edit that inserts text immediately before node.
+func (c *C) InsertBefore(node ast.Node, text string) Edit {
+	pos := c.pos(node)
+	return Edit{Pos: pos, End: pos, Text: text}
+}
+
+// fileParser returns the parser used to parse included files, creating one
+// that searches Dirs if the C wasn't created by a Linter.
+func (c *C) fileParser() *FileParser {
+	if c.parser == nil {
+		c.parser = NewFileParser(c.Dirs)
+	}
+	return c.parser
 }
 
 // Resolve resolves a name.
 func (c *C) Resolve(name string) ast.Node {
-	if n, err := Resolve(name, c.Program, c.parser); err == nil {
-		return n
-	}
-	return nil
+	n, _ := c.Lookup(name)
+	return n
+}
+
+// Lookup resolves a name like Resolve, but also returns an error describing
+// why the name couldn't be resolved. The error wraps [ErrIncludeMissing],
+// [ErrIncludeNotFound], or [ErrUndefined] when one of them applies.
+func (c *C) Lookup(name string) (ast.Node, error) {
+	n, _, err := resolve(name, c.Program, c.fileParser(), filepath.Dir(c.Filename))
+	return n, err
 }
 
 // ResolveConstant resolves a constant reference to its target.
 func (c *C) ResolveConstant(ref ast.ConstantReference) ast.Node {
-	if n, err := ResolveConstant(ref, c.Program, c.parser); err == nil {
-		return n
-	}
-	return nil
+	n, _ := c.ResolveConstantIn(c.Filename, ref)
+	return n
 }
 
 // ResolveType resolves a type reference to its target type.
 func (c *C) ResolveType(ref ast.TypeReference) ast.Node {
-	if n, err := ResolveType(ref, c.Program, c.parser); err == nil {
-		return n
+	n, _ := c.ResolveTypeIn(c.Filename, ref)
+	return n
+}
+
+// ResolveConstantIn resolves a constant reference that appears in filename,
+// which is either c.Filename or the path of a file that it (transitively)
+// includes. It also returns the name of the file that defines the target, so
+// that references found in the target can be resolved in turn.
+func (c *C) ResolveConstantIn(filename string, ref ast.ConstantReference) (ast.Node, string) {
+	program := c.programIn(filename)
+	if program == nil {
+		return nil, ""
+	}
+	n, path, err := resolveConstant(ref, program, c.fileParser(), filepath.Dir(filename))
+	if err != nil {
+		return nil, ""
+	}
+	if path == "" {
+		path = filename
+	}
+	return n, path
+}
+
+// ResolveTypeIn resolves a type reference that appears in filename, like
+// ResolveConstantIn.
+func (c *C) ResolveTypeIn(filename string, ref ast.TypeReference) (ast.Node, string) {
+	program := c.programIn(filename)
+	if program == nil {
+		return nil, ""
+	}
+	n, path, err := resolveType(ref, program, c.fileParser(), filepath.Dir(filename))
+	if err != nil {
+		return nil, ""
+	}
+	if path == "" {
+		path = filename
+	}
+	return n, path
+}
+
+// programIn returns the program for filename, which is c.Program for the
+// linted file.
+func (c *C) programIn(filename string) *ast.Program {
+	if filename == c.Filename {
+		return c.Program
+	}
+	program, _, err := c.fileParser().ParseFile(filename)
+	if err != nil {
+		return nil
 	}
-	return nil
+	return program
 }
 
 // IsTypeAllowed checks if a type is allowed.
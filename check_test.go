@@ -48,6 +48,36 @@ func TestNewCheck(t *testing.T) {
 	}
 }
 
+func TestNewProjectCheck(t *testing.T) {
+	func() {
+		defer func() { _ = recover() }()
+		NewProjectCheck("", nil)
+		t.Errorf("should have panicked")
+	}()
+
+	called := false
+	check := NewProjectCheck("project", func(p *P) { called = true })
+	if !check.IsProject() {
+		t.Error("expected a project check")
+	}
+	if check.Call(&C{}, &ast.Program{}) {
+		t.Error("unexpected call of a project check with a node")
+	}
+
+	p := &P{}
+	if !check.CallProject(p) || !called {
+		t.Error("expected project check to be called")
+	}
+	if p.Check != "project" {
+		t.Errorf("expected check name %q, got %q", "project", p.Check)
+	}
+
+	nodeCheck := NewCheck("node", func(c *C, n ast.Node) {})
+	if nodeCheck.IsProject() || nodeCheck.CallProject(p) {
+		t.Error("unexpected project call of a node check")
+	}
+}
+
 func TestCall(t *testing.T) {
 	nodes := []ast.Node{
 		&ast.Field{},
@@ -15,7 +15,10 @@
 package checks_test
 
 import (
+	"os"
+	"path/filepath"
 	"reflect"
+	"sort"
 	"testing"
 
 	"github.com/pinterest/thriftcheck"
@@ -23,10 +26,12 @@ import (
 )
 
 type Test struct {
-	name string
-	prog *ast.Program
-	node ast.Node
-	want []string
+	name      string
+	prog      *ast.Program
+	prev      *ast.Program
+	node      ast.Node
+	ancestors []ast.Node
+	want      []string
 }
 
 func RunTests(t *testing.T, check *thriftcheck.Check, tests []Test) {
@@ -36,13 +41,14 @@ func RunTests(t *testing.T, check *thriftcheck.Check, tests []Test) {
 		c := &thriftcheck.C{
 			Filename: tt.name,
 			Program:  tt.prog,
+			Previous: tt.prev,
 			Check:    check.Name,
 		}
 		if c.Filename == "" {
 			c.Filename = "t.thrift"
 		}
 
-		check.Call(c, tt.node)
+		check.Call(c, append([]ast.Node{tt.node}, tt.ancestors...)...)
 
 		if len(tt.want) > 0 || len(c.Messages) > 0 {
 			strings := make([]string, len(c.Messages))
@@ -56,6 +62,62 @@ func RunTests(t *testing.T, check *thriftcheck.Check, tests []Test) {
 	}
 }
 
+type ProjectTest struct {
+	files map[string]string
+	lint  []string
+	want  []string
+}
+
+// RunProjectTests writes each test's files to a temporary directory, lints
+// the files named by lint (or all of the files) from within it, and compares
+// the project check's messages.
+func RunProjectTests(t *testing.T, check *thriftcheck.Check, tests []ProjectTest) {
+	t.Helper()
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.Chdir(cwd) }()
+
+	for _, tt := range tests {
+		dir := t.TempDir()
+		if err := os.Chdir(dir); err != nil {
+			t.Fatal(err)
+		}
+
+		var filenames []string
+		for name, content := range tt.files {
+			if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(name, []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+			filenames = append(filenames, name)
+		}
+		sort.Strings(filenames)
+		if tt.lint != nil {
+			filenames = tt.lint
+		}
+
+		msgs, err := thriftcheck.NewLinter(thriftcheck.Checks{*check}).LintFiles(filenames)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		strings := make([]string, len(msgs))
+		for i, m := range msgs {
+			strings[i] = m.String()
+		}
+		if len(tt.want) > 0 || len(strings) > 0 {
+			if !reflect.DeepEqual(strings, tt.want) {
+				t.Errorf("%v:\n- %v\n+ %v", filenames, tt.want, strings)
+			}
+		}
+	}
+}
+
 func ParseType(t *testing.T, name string) (thriftType thriftcheck.ThriftType) {
 	t.Helper()
 
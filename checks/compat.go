@@ -0,0 +1,241 @@
+// Copyright 2025 Pinterest
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checks
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/pinterest/thriftcheck"
+	"go.uber.org/thriftrw/ast"
+)
+
+// previousDefinition returns the definition with the given name from the
+// previous revision of the current program, or nil if there isn't one.
+func previousDefinition(c *thriftcheck.C, name string) ast.Definition {
+	if c.Previous == nil {
+		return nil
+	}
+	for _, def := range c.Previous.Definitions {
+		if def.Info().Name == name {
+			return def
+		}
+	}
+	return nil
+}
+
+func fieldByID(fields []*ast.Field, id int) *ast.Field {
+	for _, f := range fields {
+		if f.ID == id {
+			return f
+		}
+	}
+	return nil
+}
+
+func fieldByName(fields []*ast.Field, name string) *ast.Field {
+	for _, f := range fields {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+func functionByName(functions []*ast.Function, name string) *ast.Function {
+	for _, f := range functions {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// typeName returns a type's name without any of its annotations, which don't
+// affect its wire representation. The 8-bit integer type is called "i8", which
+// is preferred over its "byte" alias.
+func typeName(t ast.Type) string {
+	switch t := t.(type) {
+	case nil:
+		return "void"
+	case ast.BaseType:
+		if t.ID == ast.I8TypeID {
+			return "i8"
+		}
+		return ast.BaseType{ID: t.ID}.String()
+	case ast.ListType:
+		return fmt.Sprintf("list<%s>", typeName(t.ValueType))
+	case ast.SetType:
+		return fmt.Sprintf("set<%s>", typeName(t.ValueType))
+	case ast.MapType:
+		return fmt.Sprintf("map<%s, %s>", typeName(t.KeyType), typeName(t.ValueType))
+	default:
+		return t.String()
+	}
+}
+
+// requirednessName returns the keyword for a field's requiredness, or
+// "default" if it wasn't declared.
+func requirednessName(r ast.Requiredness) string {
+	switch r {
+	case ast.Required:
+		return "required"
+	case ast.Optional:
+		return "optional"
+	default:
+		return "default"
+	}
+}
+
+// CheckCompatFieldRemoved reports an error if a field that was part of the
+// previous revision of a structure has been removed, including when its ID has
+// been reused by a field with a different name. Fields that were only assigned
+// a new ID are reported by the "compat.field.id" check instead.
+func CheckCompatFieldRemoved() thriftcheck.Check {
+	return thriftcheck.NewCheck("compat.field.removed", func(c *thriftcheck.C, s *ast.Struct) {
+		if prev, ok := previousDefinition(c, s.Name).(*ast.Struct); ok {
+			for _, pf := range prev.Fields {
+				if fieldByName(s.Fields, pf.Name) != nil {
+					continue
+				}
+				if f := fieldByID(s.Fields, pf.ID); f != nil {
+					c.Errorf(s, "field %q (%d) was removed from %q and its ID reused by %q", pf.Name, pf.ID, s.Name, f.Name)
+				} else {
+					c.Errorf(s, "field %q (%d) was removed from %q", pf.Name, pf.ID, s.Name)
+				}
+			}
+		}
+	}).WithDescription("Fields must not be removed from structures")
+}
+
+// CheckCompatFieldID reports an error if a field's ID has changed since the
+// previous revision of its structure.
+func CheckCompatFieldID() thriftcheck.Check {
+	return thriftcheck.NewCheck("compat.field.id", func(c *thriftcheck.C, s *ast.Struct, f *ast.Field) {
+		if prev, ok := previousDefinition(c, s.Name).(*ast.Struct); ok {
+			if pf := fieldByName(prev.Fields, f.Name); pf != nil && pf.ID != f.ID {
+				c.Errorf(f, "field %q changed ID from %d to %d", f.Name, pf.ID, f.ID)
+			}
+		}
+	}).WithDescription("Field IDs must not change")
+}
+
+// CheckCompatFieldType reports an error if a field's type has changed since
+// the previous revision of its structure.
+func CheckCompatFieldType() thriftcheck.Check {
+	return thriftcheck.NewCheck("compat.field.type", func(c *thriftcheck.C, s *ast.Struct, f *ast.Field) {
+		if prev, ok := previousDefinition(c, s.Name).(*ast.Struct); ok {
+			if pf := fieldByID(prev.Fields, f.ID); pf != nil {
+				if from, to := typeName(pf.Type), typeName(f.Type); from != to {
+					c.Errorf(f, "field %q (%d) changed type from %q to %q", f.Name, f.ID, from, to)
+				}
+			}
+		}
+	}).WithDescription("Field types must not change")
+}
+
+// CheckCompatFieldRequiredness reports an error if a field that wasn't
+// "required" in the previous revision of its structure has become "required".
+func CheckCompatFieldRequiredness() thriftcheck.Check {
+	return thriftcheck.NewCheck("compat.field.requiredness", func(c *thriftcheck.C, s *ast.Struct, f *ast.Field) {
+		if prev, ok := previousDefinition(c, s.Name).(*ast.Struct); ok {
+			if pf := fieldByID(prev.Fields, f.ID); pf != nil && pf.Requiredness != ast.Required && f.Requiredness == ast.Required {
+				c.Errorf(f, `field %q (%d) changed from %q to "required"`, f.Name, f.ID, requirednessName(pf.Requiredness))
+			}
+		}
+	}).WithDescription("Fields must not become required")
+}
+
+// CheckCompatEnumRemoved reports an error if an enumeration value that was part
+// of its previous revision has been removed. Values are compared numerically,
+// so renaming an item is allowed.
+func CheckCompatEnumRemoved() thriftcheck.Check {
+	return thriftcheck.NewCheck("compat.enum.removed", func(c *thriftcheck.C, e *ast.Enum) {
+		if prev, ok := previousDefinition(c, e.Name).(*ast.Enum); ok {
+			values := enumValues(e)
+			for i, value := range enumValues(prev) {
+				if !slices.Contains(values, value) {
+					c.Errorf(e, "enum value %s (%d) was removed from %q", prev.Items[i].Name, value, e.Name)
+				}
+			}
+		}
+	}).WithDescription("Enumeration values must not be removed")
+}
+
+// CheckCompatFunctionRemoved reports an error if a function that was part of
+// the previous revision of a service has been removed.
+func CheckCompatFunctionRemoved() thriftcheck.Check {
+	return thriftcheck.NewCheck("compat.function.removed", func(c *thriftcheck.C, s *ast.Service) {
+		if prev, ok := previousDefinition(c, s.Name).(*ast.Service); ok {
+			for _, pf := range prev.Functions {
+				if functionByName(s.Functions, pf.Name) == nil {
+					c.Errorf(s, "function %q was removed from %q", pf.Name, s.Name)
+				}
+			}
+		}
+	}).WithDescription("Functions must not be removed from services")
+}
+
+// CheckCompatFunctionSignature reports an error if a function's signature has
+// changed in an incompatible way since the previous revision of its service:
+// its return type changed, it became (or stopped being) "oneway", any of its
+// parameters were removed, changed type, or became "required", a "required"
+// parameter was added, or any of its exceptions were removed or changed type.
+func CheckCompatFunctionSignature() thriftcheck.Check {
+	return thriftcheck.NewCheck("compat.function.signature", func(c *thriftcheck.C, s *ast.Service, f *ast.Function) {
+		prev, ok := previousDefinition(c, s.Name).(*ast.Service)
+		if !ok {
+			return
+		}
+		pf := functionByName(prev.Functions, f.Name)
+		if pf == nil {
+			return
+		}
+
+		if from, to := typeName(pf.ReturnType), typeName(f.ReturnType); from != to {
+			c.Errorf(f, "function %q changed return type from %q to %q", f.Name, from, to)
+		}
+		if pf.OneWay != f.OneWay {
+			if f.OneWay {
+				c.Errorf(f, `function %q became "oneway"`, f.Name)
+			} else {
+				c.Errorf(f, `function %q is no longer "oneway"`, f.Name)
+			}
+		}
+		for _, pp := range pf.Parameters {
+			p := fieldByID(f.Parameters, pp.ID)
+			if p == nil {
+				c.Errorf(f, "function %q parameter %q (%d) was removed", f.Name, pp.Name, pp.ID)
+			} else if from, to := typeName(pp.Type), typeName(p.Type); from != to {
+				c.Errorf(f, "function %q parameter %q (%d) changed type from %q to %q", f.Name, p.Name, p.ID, from, to)
+			} else if pp.Requiredness != ast.Required && p.Requiredness == ast.Required {
+				c.Errorf(f, `function %q parameter %q (%d) changed from %q to "required"`, f.Name, p.Name, p.ID, requirednessName(pp.Requiredness))
+			}
+		}
+		for _, p := range f.Parameters {
+			if p.Requiredness == ast.Required && fieldByID(pf.Parameters, p.ID) == nil {
+				c.Errorf(f, `function %q added "required" parameter %q (%d)`, f.Name, p.Name, p.ID)
+			}
+		}
+		for _, pe := range pf.Exceptions {
+			e := fieldByID(f.Exceptions, pe.ID)
+			if e == nil {
+				c.Errorf(f, "function %q exception %q (%d) was removed", f.Name, pe.Name, pe.ID)
+			} else if from, to := typeName(pe.Type), typeName(e.Type); from != to {
+				c.Errorf(f, "function %q exception %q (%d) changed type from %q to %q", f.Name, e.Name, e.ID, from, to)
+			}
+		}
+	}).WithDescription("Function signatures must not change incompatibly")
+}
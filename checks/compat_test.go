@@ -0,0 +1,354 @@
+// Copyright 2025 Pinterest
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/pinterest/thriftcheck/checks"
+	"go.uber.org/thriftrw/ast"
+)
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func program(defs ...ast.Definition) *ast.Program {
+	return &ast.Program{Definitions: defs}
+}
+
+var (
+	i32Type    = ast.BaseType{ID: ast.I32TypeID}
+	i64Type    = ast.BaseType{ID: ast.I64TypeID}
+	stringType = ast.BaseType{ID: ast.StringTypeID}
+)
+
+func TestCheckCompatFieldRemoved(t *testing.T) {
+	prev := program(&ast.Struct{Name: "S", Fields: []*ast.Field{
+		{ID: 1, Name: "a"},
+		{ID: 2, Name: "b"},
+	}})
+
+	tests := []Test{
+		{
+			node: &ast.Struct{Name: "S", Fields: []*ast.Field{{ID: 1, Name: "a"}, {ID: 2, Name: "b"}}},
+			want: []string{},
+		},
+		{
+			prev: prev,
+			node: &ast.Struct{Name: "S", Fields: []*ast.Field{{ID: 1, Name: "a"}, {ID: 2, Name: "b"}, {ID: 3, Name: "c"}}},
+			want: []string{},
+		},
+		{
+			prev: prev,
+			node: &ast.Struct{Name: "S", Fields: []*ast.Field{{ID: 1, Name: "a"}, {ID: 3, Name: "b"}}},
+			want: []string{},
+		},
+		{
+			prev: prev,
+			node: &ast.Struct{Name: "S", Fields: []*ast.Field{{ID: 1, Name: "a"}}},
+			want: []string{
+				`t.thrift:0:1: error: field "b" (2) was removed from "S" (compat.field.removed)`,
+			},
+		},
+		{
+			prev: prev,
+			node: &ast.Struct{Name: "S", Fields: []*ast.Field{{ID: 1, Name: "a"}, {ID: 2, Name: "c"}}},
+			want: []string{
+				`t.thrift:0:1: error: field "b" (2) was removed from "S" and its ID reused by "c" (compat.field.removed)`,
+			},
+		},
+		{
+			prev: prev,
+			node: &ast.Struct{Name: "T"},
+			want: []string{},
+		},
+	}
+
+	check := checks.CheckCompatFieldRemoved()
+	RunTests(t, &check, tests)
+}
+
+func TestCheckCompatFieldID(t *testing.T) {
+	prev := program(&ast.Struct{Name: "S", Fields: []*ast.Field{{ID: 1, Name: "a"}}})
+	s := &ast.Struct{Name: "S"}
+
+	tests := []Test{
+		{
+			prev:      prev,
+			node:      &ast.Field{ID: 1, Name: "a"},
+			ancestors: []ast.Node{s},
+			want:      []string{},
+		},
+		{
+			prev:      prev,
+			node:      &ast.Field{ID: 2, Name: "a"},
+			ancestors: []ast.Node{s},
+			want: []string{
+				`t.thrift:0:1: error: field "a" changed ID from 1 to 2 (compat.field.id)`,
+			},
+		},
+		{
+			prev:      prev,
+			node:      &ast.Field{ID: 2, Name: "b"},
+			ancestors: []ast.Node{s},
+			want:      []string{},
+		},
+	}
+
+	check := checks.CheckCompatFieldID()
+	RunTests(t, &check, tests)
+}
+
+func TestCheckCompatFieldType(t *testing.T) {
+	prev := program(&ast.Struct{Name: "S", Fields: []*ast.Field{
+		{ID: 1, Name: "a", Type: i32Type},
+		{ID: 2, Name: "b", Type: ast.ListType{ValueType: ast.TypeReference{Name: "T"}}},
+	}})
+	s := &ast.Struct{Name: "S"}
+
+	tests := []Test{
+		{
+			prev:      prev,
+			node:      &ast.Field{ID: 1, Name: "a", Type: i32Type},
+			ancestors: []ast.Node{s},
+			want:      []string{},
+		},
+		{
+			prev: prev,
+			node: &ast.Field{ID: 1, Name: "a", Type: ast.BaseType{
+				ID:          ast.I32TypeID,
+				Annotations: []*ast.Annotation{{Name: "js.type", Value: "Long"}},
+			}},
+			ancestors: []ast.Node{s},
+			want:      []string{},
+		},
+		{
+			prev:      prev,
+			node:      &ast.Field{ID: 1, Name: "a", Type: i64Type},
+			ancestors: []ast.Node{s},
+			want: []string{
+				`t.thrift:0:1: error: field "a" (1) changed type from "i32" to "i64" (compat.field.type)`,
+			},
+		},
+		{
+			prev:      prev,
+			node:      &ast.Field{ID: 2, Name: "b", Type: ast.SetType{ValueType: ast.TypeReference{Name: "T"}}},
+			ancestors: []ast.Node{s},
+			want: []string{
+				`t.thrift:0:1: error: field "b" (2) changed type from "list<T>" to "set<T>" (compat.field.type)`,
+			},
+		},
+		{
+			prev:      prev,
+			node:      &ast.Field{ID: 3, Name: "c", Type: stringType},
+			ancestors: []ast.Node{s},
+			want:      []string{},
+		},
+	}
+
+	check := checks.CheckCompatFieldType()
+	RunTests(t, &check, tests)
+}
+
+func TestCheckCompatFieldRequiredness(t *testing.T) {
+	prev := program(&ast.Struct{Name: "S", Fields: []*ast.Field{
+		{ID: 1, Name: "a", Requiredness: ast.Optional},
+		{ID: 2, Name: "b", Requiredness: ast.Required},
+		{ID: 3, Name: "c"},
+	}})
+	s := &ast.Struct{Name: "S"}
+
+	tests := []Test{
+		{
+			prev:      prev,
+			node:      &ast.Field{ID: 1, Name: "a", Requiredness: ast.Optional},
+			ancestors: []ast.Node{s},
+			want:      []string{},
+		},
+		{
+			prev:      prev,
+			node:      &ast.Field{ID: 1, Name: "a", Requiredness: ast.Required},
+			ancestors: []ast.Node{s},
+			want: []string{
+				`t.thrift:0:1: error: field "a" (1) changed from "optional" to "required" (compat.field.requiredness)`,
+			},
+		},
+		{
+			prev:      prev,
+			node:      &ast.Field{ID: 2, Name: "b", Requiredness: ast.Required},
+			ancestors: []ast.Node{s},
+			want:      []string{},
+		},
+		{
+			prev:      prev,
+			node:      &ast.Field{ID: 3, Name: "c", Requiredness: ast.Required},
+			ancestors: []ast.Node{s},
+			want: []string{
+				`t.thrift:0:1: error: field "c" (3) changed from "default" to "required" (compat.field.requiredness)`,
+			},
+		},
+	}
+
+	check := checks.CheckCompatFieldRequiredness()
+	RunTests(t, &check, tests)
+}
+
+func TestCheckCompatEnumRemoved(t *testing.T) {
+	prev := program(&ast.Enum{Name: "E", Items: []*ast.EnumItem{
+		{Name: "A"},
+		{Name: "B"},
+		{Name: "C", Value: intPtr(10)},
+	}})
+
+	tests := []Test{
+		{
+			prev: prev,
+			node: &ast.Enum{Name: "E", Items: []*ast.EnumItem{
+				{Name: "A", Value: intPtr(0)},
+				{Name: "RENAMED", Value: intPtr(1)},
+				{Name: "C", Value: intPtr(10)},
+				{Name: "D"},
+			}},
+			want: []string{},
+		},
+		{
+			prev: prev,
+			node: &ast.Enum{Name: "E", Items: []*ast.EnumItem{
+				{Name: "A"},
+				{Name: "C", Value: intPtr(10)},
+			}},
+			want: []string{
+				`t.thrift:0:1: error: enum value B (1) was removed from "E" (compat.enum.removed)`,
+			},
+		},
+	}
+
+	check := checks.CheckCompatEnumRemoved()
+	RunTests(t, &check, tests)
+}
+
+func TestCheckCompatFunctionRemoved(t *testing.T) {
+	prev := program(&ast.Service{Name: "S", Functions: []*ast.Function{
+		{Name: "a"},
+		{Name: "b"},
+	}})
+
+	tests := []Test{
+		{
+			prev: prev,
+			node: &ast.Service{Name: "S", Functions: []*ast.Function{{Name: "b"}, {Name: "a"}, {Name: "c"}}},
+			want: []string{},
+		},
+		{
+			prev: prev,
+			node: &ast.Service{Name: "S", Functions: []*ast.Function{{Name: "a"}}},
+			want: []string{
+				`t.thrift:0:1: error: function "b" was removed from "S" (compat.function.removed)`,
+			},
+		},
+	}
+
+	check := checks.CheckCompatFunctionRemoved()
+	RunTests(t, &check, tests)
+}
+
+func TestCheckCompatFunctionSignature(t *testing.T) {
+	prev := program(&ast.Service{Name: "S", Functions: []*ast.Function{
+		{Name: "f", ReturnType: i32Type, Parameters: []*ast.Field{
+			{ID: 1, Name: "a", Type: i32Type},
+			{ID: 2, Name: "b", Type: stringType},
+		}},
+		{Name: "g", OneWay: true},
+		{Name: "t", Parameters: []*ast.Field{
+			{ID: 1, Name: "a", Type: i32Type, Requiredness: ast.Optional},
+		}, Exceptions: []*ast.Field{
+			{ID: 1, Name: "e1", Type: ast.TypeReference{Name: "E1"}},
+			{ID: 2, Name: "e2", Type: ast.TypeReference{Name: "E2"}},
+		}},
+	}})
+	s := &ast.Service{Name: "S"}
+
+	tests := []Test{
+		{
+			prev: prev,
+			node: &ast.Function{Name: "f", ReturnType: i32Type, Parameters: []*ast.Field{
+				{ID: 1, Name: "a", Type: i32Type},
+				{ID: 2, Name: "renamed", Type: stringType},
+				{ID: 3, Name: "c", Type: stringType},
+			}},
+			ancestors: []ast.Node{s},
+			want:      []string{},
+		},
+		{
+			prev: prev,
+			node: &ast.Function{Name: "f", Parameters: []*ast.Field{
+				{ID: 1, Name: "a", Type: i64Type},
+			}},
+			ancestors: []ast.Node{s},
+			want: []string{
+				`t.thrift:0:1: error: function "f" changed return type from "i32" to "void" (compat.function.signature)`,
+				`t.thrift:0:1: error: function "f" parameter "a" (1) changed type from "i32" to "i64" (compat.function.signature)`,
+				`t.thrift:0:1: error: function "f" parameter "b" (2) was removed (compat.function.signature)`,
+			},
+		},
+		{
+			prev:      prev,
+			node:      &ast.Function{Name: "g"},
+			ancestors: []ast.Node{s},
+			want: []string{
+				`t.thrift:0:1: error: function "g" is no longer "oneway" (compat.function.signature)`,
+			},
+		},
+		{
+			prev:      prev,
+			node:      &ast.Function{Name: "h", OneWay: true},
+			ancestors: []ast.Node{s},
+			want:      []string{},
+		},
+		{
+			prev: prev,
+			node: &ast.Function{Name: "t", Parameters: []*ast.Field{
+				{ID: 1, Name: "a", Type: i32Type, Requiredness: ast.Optional},
+				{ID: 2, Name: "b", Type: i32Type, Requiredness: ast.Optional},
+			}, Exceptions: []*ast.Field{
+				{ID: 1, Name: "e1", Type: ast.TypeReference{Name: "E1"}},
+				{ID: 2, Name: "e2", Type: ast.TypeReference{Name: "E2"}},
+				{ID: 3, Name: "e3", Type: ast.TypeReference{Name: "E3"}},
+			}},
+			ancestors: []ast.Node{s},
+			want:      []string{},
+		},
+		{
+			prev: prev,
+			node: &ast.Function{Name: "t", Parameters: []*ast.Field{
+				{ID: 1, Name: "a", Type: i32Type, Requiredness: ast.Required},
+				{ID: 2, Name: "b", Type: i32Type, Requiredness: ast.Required},
+			}, Exceptions: []*ast.Field{
+				{ID: 1, Name: "e1", Type: ast.TypeReference{Name: "E3"}},
+			}},
+			ancestors: []ast.Node{s},
+			want: []string{
+				`t.thrift:0:1: error: function "t" parameter "a" (1) changed from "optional" to "required" (compat.function.signature)`,
+				`t.thrift:0:1: error: function "t" added "required" parameter "b" (2) (compat.function.signature)`,
+				`t.thrift:0:1: error: function "t" exception "e1" (1) changed type from "E1" to "E3" (compat.function.signature)`,
+				`t.thrift:0:1: error: function "t" exception "e2" (2) was removed (compat.function.signature)`,
+			},
+		},
+	}
+
+	check := checks.CheckCompatFunctionSignature()
+	RunTests(t, &check, tests)
+}
@@ -15,6 +15,10 @@
 package checks
 
 import (
+	"fmt"
+	"math"
+	"slices"
+
 	"github.com/pinterest/thriftcheck"
 	"go.uber.org/thriftrw/ast"
 )
@@ -26,5 +30,263 @@ func CheckConstantRef() thriftcheck.Check {
 		if c.ResolveConstant(ref) == nil {
 			c.Errorf(ref, "unable to find a constant or enum value named %q", ref.Name)
 		}
-	})
+	}).WithDescription("Referenced constants and enum values must be resolvable")
+}
+
+// CheckConstantType returns a thriftcheck.Check that reports an error if a
+// constant's value isn't compatible with its declared type. Integers that are
+// out of range for their type are reported by CheckIntegerRange.
+func CheckConstantType() thriftcheck.Check {
+	return thriftcheck.NewCheck("constant.type", func(c *thriftcheck.C, k *ast.Constant) {
+		if reason := (valueChecker{c: c}).check(k.Type, c.Filename, k.Value, c.Filename, 0); reason != "" {
+			c.Errorf(k, "value of constant %q is not a valid %s: %s", k.Name, typeName(k.Type), reason)
+		}
+	}).WithDescription("Constant values must match their types")
+}
+
+// CheckFieldDefaultType returns a thriftcheck.Check that reports an error if a
+// field's default value isn't compatible with the field's type.
+func CheckFieldDefaultType() thriftcheck.Check {
+	return thriftcheck.NewCheck("field.default.type", func(c *thriftcheck.C, f *ast.Field) {
+		if f.Default == nil {
+			return
+		}
+		if reason := (valueChecker{c: c}).check(f.Type, c.Filename, f.Default, c.Filename, 0); reason != "" {
+			c.Errorf(f, "default value of field %q (%d) is not a valid %s: %s", f.Name, f.ID, typeName(f.Type), reason)
+		}
+	}).WithDescription("Field default values must match their types")
+}
+
+// maxValueDepth limits how many typedefs and constant references are followed
+// while checking a value, which guards against reference cycles.
+const maxValueDepth = 32
+
+// followType follows type references, including chains of typedefs, to the
+// underlying type. Each reference is resolved in the file that contains it,
+// starting with filename. It returns the type along with the name of the file
+// that defines it, or nil if the type can't be resolved. The names of the
+// references that were followed, as written, are returned as the path.
+func followType(c *thriftcheck.C, t ast.Type, filename string) (n ast.Node, _ string, path []string) {
+	n = t
+	for range maxValueDepth {
+		ref, ok := n.(ast.TypeReference)
+		if !ok {
+			return n, filename, path
+		}
+		path = append(path, ref.Name)
+		if n, filename = c.ResolveTypeIn(filename, ref); n == nil {
+			return nil, "", path
+		}
+	}
+	return nil, "", path
+}
+
+// describeType describes a resolved type for use in messages.
+func describeType(n ast.Node) string {
+	switch t := n.(type) {
+	case ast.BaseType:
+		return typeName(t)
+	case ast.ListType:
+		return "a list"
+	case ast.SetType:
+		return "a set"
+	case ast.MapType:
+		return "a map"
+	case *ast.Enum:
+		return fmt.Sprintf("enum %q", t.Name)
+	case *ast.Struct:
+		return fmt.Sprintf("%s %q", structureKind(t.Type), t.Name)
+	}
+	return fmt.Sprintf("%T", n)
+}
+
+// describeValue describes a constant value for use in messages.
+func describeValue(v ast.ConstantValue) string {
+	switch v.(type) {
+	case ast.ConstantBoolean:
+		return "a boolean"
+	case ast.ConstantInteger:
+		return "an integer"
+	case ast.ConstantDouble:
+		return "a double"
+	case ast.ConstantString:
+		return "a string"
+	case ast.ConstantList:
+		return "a list"
+	case ast.ConstantMap:
+		return "a map"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+var integerRanges = map[ast.BaseTypeID][2]int64{
+	ast.I8TypeID:  {math.MinInt8, math.MaxInt8},
+	ast.I16TypeID: {math.MinInt16, math.MaxInt16},
+	ast.I32TypeID: {math.MinInt32, math.MaxInt32},
+	ast.I64TypeID: {math.MinInt64, math.MaxInt64},
+}
+
+// valueChecker checks that constant values are compatible with their types.
+// Because either can come from an included file, names in a type are resolved
+// in typeFile and names in a value in valueFile.
+//
+// Type and value mismatches are reported by default, while integers that are
+// out of range for their type are only reported when ranges is set, in which
+// case nothing else is.
+type valueChecker struct {
+	c      *thriftcheck.C
+	ranges bool
+}
+
+// fail returns a reason describing a mismatch, unless only ranges are checked.
+func (vc valueChecker) fail(format string, args ...any) string {
+	if vc.ranges {
+		return ""
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// check checks a constant value against a type. It returns a reason
+// describing the first problem it finds, or "" if there aren't any. Types and
+// references that can't be resolved are assumed to be compatible; they are
+// reported by the type.ref and constant.ref checks.
+func (vc valueChecker) check(t ast.Type, typeFile string, v ast.ConstantValue, valueFile string, depth int) string {
+	if depth > maxValueDepth {
+		return ""
+	}
+
+	n, typeFile, _ := followType(vc.c, t, typeFile)
+	if n == nil {
+		return ""
+	}
+
+	if ref, ok := v.(ast.ConstantReference); ok {
+		target, filename := vc.c.ResolveConstantIn(valueFile, ref)
+		switch target := target.(type) {
+		case *ast.Constant:
+			return vc.check(t, typeFile, target.Value, filename, depth+1)
+		case *ast.EnumItem:
+			if e, ok := n.(*ast.Enum); ok && slices.Contains(e.Items, target) {
+				return ""
+			}
+			return vc.fail("expected %s, got an enum value", describeType(n))
+		default:
+			return ""
+		}
+	}
+
+	switch t := n.(type) {
+	case ast.BaseType:
+		switch t.ID {
+		case ast.BoolTypeID:
+			if i, ok := v.(ast.ConstantInteger); ok && (i == 0 || i == 1) {
+				return ""
+			}
+			if _, ok := v.(ast.ConstantBoolean); ok {
+				return ""
+			}
+		case ast.I8TypeID, ast.I16TypeID, ast.I32TypeID, ast.I64TypeID:
+			if i, ok := v.(ast.ConstantInteger); ok {
+				if r := integerRanges[t.ID]; vc.ranges && (int64(i) < r[0] || int64(i) > r[1]) {
+					return fmt.Sprintf("%d is out of range for %s", i, typeName(t))
+				}
+				return ""
+			}
+		case ast.DoubleTypeID:
+			switch v.(type) {
+			case ast.ConstantInteger, ast.ConstantDouble:
+				return ""
+			}
+		case ast.StringTypeID, ast.BinaryTypeID:
+			if _, ok := v.(ast.ConstantString); ok {
+				return ""
+			}
+		}
+
+	case ast.ListType:
+		if l, ok := v.(ast.ConstantList); ok {
+			return vc.checkItems(t.ValueType, typeFile, l, valueFile, depth)
+		}
+
+	case ast.SetType:
+		if l, ok := v.(ast.ConstantList); ok {
+			return vc.checkItems(t.ValueType, typeFile, l, valueFile, depth)
+		}
+
+	case ast.MapType:
+		m, ok := v.(ast.ConstantMap)
+		if !ok {
+			break
+		}
+		for i, item := range m.Items {
+			if reason := vc.check(t.KeyType, typeFile, item.Key, valueFile, depth+1); reason != "" {
+				return fmt.Sprintf("key %d: %s", i, reason)
+			}
+			if reason := vc.check(t.ValueType, typeFile, item.Value, valueFile, depth+1); reason != "" {
+				return fmt.Sprintf("value %d: %s", i, reason)
+			}
+		}
+		return ""
+
+	case *ast.Enum:
+		if i, ok := v.(ast.ConstantInteger); ok {
+			if slices.Contains(enumValues(t), int(i)) {
+				return ""
+			}
+			return vc.fail("%d is not a value of enum %q", i, t.Name)
+		}
+
+	case *ast.Struct:
+		m, ok := v.(ast.ConstantMap)
+		if !ok {
+			break
+		}
+		return vc.checkStruct(t, typeFile, m, valueFile, depth)
+
+	default:
+		return ""
+	}
+
+	return vc.fail("expected %s, got %s", describeType(n), describeValue(v))
+}
+
+// checkItems checks the items of a list or set value.
+func (vc valueChecker) checkItems(t ast.Type, typeFile string, l ast.ConstantList, valueFile string, depth int) string {
+	for i, item := range l.Items {
+		if reason := vc.check(t, typeFile, item, valueFile, depth+1); reason != "" {
+			return fmt.Sprintf("item %d: %s", i, reason)
+		}
+	}
+	return ""
+}
+
+// checkStruct checks a map value that initializes a struct's fields.
+func (vc valueChecker) checkStruct(s *ast.Struct, typeFile string, m ast.ConstantMap, valueFile string, depth int) string {
+	set := make(map[string]bool, len(m.Items))
+	for _, item := range m.Items {
+		key, ok := item.Key.(ast.ConstantString)
+		if !ok {
+			if reason := vc.fail("field names must be strings, got %s", describeValue(item.Key)); reason != "" {
+				return reason
+			}
+			continue
+		}
+		set[string(key)] = true
+		i := slices.IndexFunc(s.Fields, func(f *ast.Field) bool { return f.Name == string(key) })
+		if i < 0 {
+			if reason := vc.fail("%s %q has no field %q", structureKind(s.Type), s.Name, key); reason != "" {
+				return reason
+			}
+			continue
+		}
+		if reason := vc.check(s.Fields[i].Type, typeFile, item.Value, valueFile, depth+1); reason != "" {
+			return fmt.Sprintf("field %q: %s", key, reason)
+		}
+	}
+	for _, f := range s.Fields {
+		if f.Requiredness == ast.Required && f.Default == nil && !set[f.Name] {
+			return vc.fail("missing required field %q", f.Name)
+		}
+	}
+	return ""
 }
@@ -54,3 +54,201 @@ func TestCheckConstantRef(t *testing.T) {
 	check := checks.CheckConstantRef()
 	RunTests(t, &check, tests)
 }
+
+func TestCheckConstantType(t *testing.T) {
+	prog := program(
+		&ast.Enum{Name: "Status", Items: []*ast.EnumItem{{Name: "UNKNOWN"}, {Name: "ACTIVE"}}},
+		&ast.Enum{Name: "Other", Items: []*ast.EnumItem{{Name: "X"}}},
+		&ast.Typedef{Name: "Small", Type: ast.BaseType{ID: ast.I16TypeID}},
+		&ast.Typedef{Name: "Tiny", Type: ast.TypeReference{Name: "Small"}},
+		&ast.Constant{Name: "NAME", Type: stringType, Value: ast.ConstantString("n")},
+		&ast.Struct{Name: "S", Fields: []*ast.Field{
+			{ID: 1, Name: "a", Type: i32Type, Requiredness: ast.Required},
+			{ID: 2, Name: "b", Type: ast.ListType{ValueType: stringType}},
+		}},
+	)
+
+	tests := []Test{
+		{
+			prog: prog,
+			node: &ast.Constant{Name: "C", Type: i32Type, Value: ast.ConstantInteger(1)},
+			want: []string{},
+		},
+		{
+			prog: prog,
+			node: &ast.Constant{Name: "C", Type: i32Type, Value: ast.ConstantString("abc")},
+			want: []string{
+				`t.thrift:0:1: error: value of constant "C" is not a valid i32: expected i32, got a string (constant.type)`,
+			},
+		},
+		{
+			prog: prog,
+			node: &ast.Constant{Name: "C", Type: ast.TypeReference{Name: "Tiny"}, Value: ast.ConstantInteger(70000)},
+			want: []string{},
+		},
+		{
+			prog: prog,
+			node: &ast.Constant{Name: "C", Type: ast.TypeReference{Name: "Status"}, Value: ast.ConstantReference{Name: "Status.ACTIVE"}},
+			want: []string{},
+		},
+		{
+			prog: prog,
+			node: &ast.Constant{Name: "C", Type: ast.TypeReference{Name: "Status"}, Value: ast.ConstantReference{Name: "Other.X"}},
+			want: []string{
+				`t.thrift:0:1: error: value of constant "C" is not a valid Status: expected enum "Status", got an enum value (constant.type)`,
+			},
+		},
+		{
+			prog: prog,
+			node: &ast.Constant{Name: "C", Type: ast.TypeReference{Name: "Status"}, Value: ast.ConstantInteger(1)},
+			want: []string{},
+		},
+		{
+			prog: prog,
+			node: &ast.Constant{Name: "C", Type: ast.TypeReference{Name: "Status"}, Value: ast.ConstantInteger(2)},
+			want: []string{
+				`t.thrift:0:1: error: value of constant "C" is not a valid Status: 2 is not a value of enum "Status" (constant.type)`,
+			},
+		},
+		{
+			prog: prog,
+			node: &ast.Constant{Name: "C", Type: ast.TypeReference{Name: "Status"}, Value: ast.ConstantReference{Name: "Status.BOGUS"}},
+			want: []string{},
+		},
+		{
+			prog: prog,
+			node: &ast.Constant{Name: "C", Type: i32Type, Value: ast.ConstantReference{Name: "NAME"}},
+			want: []string{
+				`t.thrift:0:1: error: value of constant "C" is not a valid i32: expected i32, got a string (constant.type)`,
+			},
+		},
+		{
+			prog: prog,
+			node: &ast.Constant{Name: "C", Type: ast.ListType{ValueType: i32Type}, Value: ast.ConstantMap{}},
+			want: []string{
+				`t.thrift:0:1: error: value of constant "C" is not a valid list<i32>: expected a list, got a map (constant.type)`,
+			},
+		},
+		{
+			prog: prog,
+			node: &ast.Constant{Name: "C", Type: ast.SetType{ValueType: i32Type}, Value: ast.ConstantList{Items: []ast.ConstantValue{
+				ast.ConstantInteger(1),
+				ast.ConstantDouble(1.5),
+			}}},
+			want: []string{
+				`t.thrift:0:1: error: value of constant "C" is not a valid set<i32>: item 1: expected i32, got a double (constant.type)`,
+			},
+		},
+		{
+			prog: prog,
+			node: &ast.Constant{Name: "C", Type: ast.MapType{KeyType: stringType, ValueType: ast.BaseType{ID: ast.BoolTypeID}}, Value: ast.ConstantMap{Items: []ast.ConstantMapItem{
+				{Key: ast.ConstantString("a"), Value: ast.ConstantBoolean(true)},
+				{Key: ast.ConstantString("b"), Value: ast.ConstantInteger(0)},
+				{Key: ast.ConstantInteger(1), Value: ast.ConstantBoolean(false)},
+			}}},
+			want: []string{
+				`t.thrift:0:1: error: value of constant "C" is not a valid map<string, bool>: key 2: expected string, got an integer (constant.type)`,
+			},
+		},
+		{
+			prog: prog,
+			node: &ast.Constant{Name: "C", Type: ast.TypeReference{Name: "S"}, Value: ast.ConstantMap{Items: []ast.ConstantMapItem{
+				{Key: ast.ConstantString("a"), Value: ast.ConstantInteger(1)},
+				{Key: ast.ConstantString("b"), Value: ast.ConstantList{Items: []ast.ConstantValue{ast.ConstantString("x")}}},
+			}}},
+			want: []string{},
+		},
+		{
+			prog: prog,
+			node: &ast.Constant{Name: "C", Type: ast.TypeReference{Name: "S"}, Value: ast.ConstantMap{Items: []ast.ConstantMapItem{
+				{Key: ast.ConstantString("b"), Value: ast.ConstantList{}},
+			}}},
+			want: []string{
+				`t.thrift:0:1: error: value of constant "C" is not a valid S: missing required field "a" (constant.type)`,
+			},
+		},
+		{
+			prog: prog,
+			node: &ast.Constant{Name: "C", Type: ast.TypeReference{Name: "S"}, Value: ast.ConstantMap{Items: []ast.ConstantMapItem{
+				{Key: ast.ConstantString("a"), Value: ast.ConstantInteger(1)},
+				{Key: ast.ConstantString("c"), Value: ast.ConstantInteger(1)},
+			}}},
+			want: []string{
+				`t.thrift:0:1: error: value of constant "C" is not a valid S: struct "S" has no field "c" (constant.type)`,
+			},
+		},
+		{
+			prog: prog,
+			node: &ast.Constant{Name: "C", Type: ast.TypeReference{Name: "Unknown"}, Value: ast.ConstantString("x")},
+			want: []string{},
+		},
+	}
+
+	check := checks.CheckConstantType()
+	RunTests(t, &check, tests)
+}
+
+func TestCheckConstantTypeIncludes(t *testing.T) {
+	tests := []ProjectTest{
+		{
+			files: map[string]string{
+				"a.thrift": "include \"inc/b.thrift\"\n" +
+					"const b.Tiny X = 5\n" +
+					"const b.Tiny Y = 300\n" +
+					"const b.Status S = b.Status.ACTIVE\n" +
+					"const b.Status T = b.Other.X\n" +
+					"const i8 U = b.LIMIT\n",
+				"inc/b.thrift": "enum Status { UNKNOWN, ACTIVE }\n" +
+					"enum Other { X }\n" +
+					"typedef Small Tiny\n" +
+					"typedef i8 Small\n" +
+					"const Tiny LIMIT = 500\n",
+			},
+			lint: []string{"a.thrift"},
+			want: []string{
+				`a.thrift:5:1: error: value of constant "T" is not a valid b.Status: expected enum "Status", got an enum value (constant.type)`,
+			},
+		},
+		{
+			files: map[string]string{
+				"a.thrift": "include \"inc/b.thrift\"\n" +
+					"const b.BSmall X = 300\n" +
+					"const b.BSmall Y = \"abc\"\n" +
+					"const string Z = b.NAME\n",
+				"inc/b.thrift": "include \"c.thrift\"\n" +
+					"typedef c.Small BSmall\n" +
+					"const c.Small NAME = c.LIMIT\n",
+				"inc/c.thrift": "typedef i8 Small\n" +
+					"const Small LIMIT = 5\n",
+			},
+			lint: []string{"a.thrift"},
+			want: []string{
+				`a.thrift:3:1: error: value of constant "Y" is not a valid b.BSmall: expected i8, got a string (constant.type)`,
+				`a.thrift:4:1: error: value of constant "Z" is not a valid string: expected string, got an integer (constant.type)`,
+			},
+		},
+	}
+
+	check := checks.CheckConstantType()
+	RunProjectTests(t, &check, tests)
+}
+
+func TestCheckFieldDefaultType(t *testing.T) {
+	tests := []Test{
+		{node: &ast.Field{ID: 1, Name: "a", Type: i32Type}, want: []string{}},
+		{node: &ast.Field{ID: 1, Name: "a", Type: i32Type, Default: ast.ConstantInteger(1)}, want: []string{}},
+		{
+			node: &ast.Field{ID: 1, Name: "a", Type: i32Type, Default: ast.ConstantString("abc")},
+			want: []string{
+				`t.thrift:0:1: error: default value of field "a" (1) is not a valid i32: expected i32, got a string (field.default.type)`,
+			},
+		},
+		{
+			node: &ast.Field{ID: 1, Name: "a", Type: ast.BaseType{ID: ast.DoubleTypeID}, Default: ast.ConstantInteger(1)},
+			want: []string{},
+		},
+	}
+
+	check := checks.CheckFieldDefaultType()
+	RunTests(t, &check, tests)
+}
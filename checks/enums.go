@@ -15,10 +15,47 @@
 package checks
 
 import (
+	"slices"
+	"strings"
+	"unicode"
+
 	"github.com/pinterest/thriftcheck"
 	"go.uber.org/thriftrw/ast"
 )
 
+// enumValues returns the values of an enumeration's items, in order, including
+// those that were implicitly assigned.
+func enumValues(e *ast.Enum) []int {
+	values := make([]int, len(e.Items))
+	next := 0
+	for i, item := range e.Items {
+		if item.Value != nil {
+			next = *item.Value
+		}
+		values[i] = next
+		next++
+	}
+	return values
+}
+
+// upperSnakeCase converts a PascalCase or camelCase name to UPPER_SNAKE_CASE.
+// Acronyms are kept together, so "HTTPMethod" becomes "HTTP_METHOD".
+func upperSnakeCase(name string) string {
+	var b strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				b.WriteRune('_')
+			}
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return b.String()
+}
+
 // CheckEnumSize returns a thriftcheck.Check that warns or errors if an
 // enumeration's element size grows beyond a limit.
 func CheckEnumSize(warningLimit, errorLimit int) thriftcheck.Check {
@@ -29,5 +66,74 @@ func CheckEnumSize(warningLimit, errorLimit int) thriftcheck.Check {
 		} else if warningLimit > 0 && size > warningLimit {
 			c.Warningf(e, "enumeration %q has more than %d items", e.Name, warningLimit)
 		}
-	})
+	}).WithDescription("Enumerations must not grow beyond a size limit")
+}
+
+// CheckEnumPrefix returns a thriftcheck.Check that warns if an enumeration
+// item's name isn't prefixed with its enumeration's name. Either the name
+// itself ("Status_ACTIVE") or its UPPER_SNAKE_CASE form ("STATUS_ACTIVE") may
+// be used, followed by an underscore.
+func CheckEnumPrefix() thriftcheck.Check {
+	return thriftcheck.NewCheck("enum.prefix", func(c *thriftcheck.C, e *ast.Enum, i *ast.EnumItem) {
+		prefix := upperSnakeCase(e.Name) + "_"
+		if !strings.HasPrefix(i.Name, prefix) && !strings.HasPrefix(i.Name, e.Name+"_") {
+			c.Warningf(i, "enumeration item %q should be prefixed with %q", i.Name, prefix)
+		}
+	}).WithDescription("Enumeration items must be prefixed with the enumeration's name")
+}
+
+// CheckEnumSentinel returns a thriftcheck.Check that warns if an enumeration's
+// first item isn't a zero-valued sentinel. If any names are given, the
+// sentinel's name must also be one of them, optionally prefixed with the
+// enumeration's name (e.g. "STATUS_UNKNOWN").
+func CheckEnumSentinel(names []string) thriftcheck.Check {
+	return thriftcheck.NewCheck("enum.sentinel", func(c *thriftcheck.C, e *ast.Enum) {
+		if len(e.Items) == 0 {
+			return
+		}
+
+		first := e.Items[0]
+		if value := enumValues(e)[0]; value != 0 {
+			c.Warningf(first, "enumeration %q must start with a sentinel item whose value is 0, not %d", e.Name, value)
+			return
+		}
+		if len(names) > 0 && !slices.ContainsFunc(names, func(name string) bool {
+			return first.Name == name || strings.HasSuffix(first.Name, "_"+name)
+		}) {
+			c.Warningf(first, "enumeration %q must start with a sentinel item named one of %q", e.Name, names)
+		}
+	}).WithDescription("Enumerations must start with a zero-valued sentinel item")
+}
+
+// CheckEnumValueDuplicate returns a thriftcheck.Check that reports an error if
+// an enumeration item's value (explicit or implicit) is already used by an
+// earlier item.
+func CheckEnumValueDuplicate() thriftcheck.Check {
+	return thriftcheck.NewCheck("enum.value.duplicate", func(c *thriftcheck.C, e *ast.Enum, item *ast.EnumItem) {
+		values := enumValues(e)
+		i := slices.Index(e.Items, item)
+		if j := slices.Index(values, values[i]); j < i {
+			c.Errorf(item, "enumeration item %q (%d) has the same value as %q", item.Name, values[i], e.Items[j].Name)
+		}
+	}).WithDescription("Enumeration item values must be unique")
+}
+
+// CheckEnumValueMissing returns a thriftcheck.Check that reports an error if
+// an enumeration item doesn't have an explicit value.
+func CheckEnumValueMissing() thriftcheck.Check {
+	return thriftcheck.NewCheck("enum.value.missing", func(c *thriftcheck.C, e *ast.Enum, i *ast.EnumItem) {
+		if i.Value == nil {
+			c.Errorf(i, "enumeration item %q (in %q) is missing an explicit value", i.Name, e.Name)
+		}
+	}).WithDescription("Enumeration items must have explicit values")
+}
+
+// CheckEnumValueNegative returns a thriftcheck.Check that reports an error if
+// an enumeration item's value is negative.
+func CheckEnumValueNegative() thriftcheck.Check {
+	return thriftcheck.NewCheck("enum.value.negative", func(c *thriftcheck.C, e *ast.Enum, item *ast.EnumItem) {
+		if value := enumValues(e)[slices.Index(e.Items, item)]; value < 0 {
+			c.Errorf(item, "enumeration item %q (in %q) has a negative value (%d)", item.Name, e.Name, value)
+		}
+	}).WithDescription("Enumeration item values must not be negative")
 }
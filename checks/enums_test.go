@@ -44,3 +44,134 @@ func TestCheckEnumSize(t *testing.T) {
 	check := checks.CheckEnumSize(1, 2)
 	RunTests(t, &check, tests)
 }
+
+func TestCheckEnumPrefix(t *testing.T) {
+	e := &ast.Enum{Name: "HTTPMethod"}
+
+	tests := []Test{
+		{node: &ast.EnumItem{Name: "HTTP_METHOD_GET"}, ancestors: []ast.Node{e}, want: []string{}},
+		{node: &ast.EnumItem{Name: "HTTPMethod_POST"}, ancestors: []ast.Node{e}, want: []string{}},
+		{
+			node:      &ast.EnumItem{Name: "PUT"},
+			ancestors: []ast.Node{e},
+			want: []string{
+				`t.thrift:0:1: warning: enumeration item "PUT" should be prefixed with "HTTP_METHOD_" (enum.prefix)`,
+			},
+		},
+		{
+			node:      &ast.EnumItem{Name: "HTTP_DELETE"},
+			ancestors: []ast.Node{e},
+			want: []string{
+				`t.thrift:0:1: warning: enumeration item "HTTP_DELETE" should be prefixed with "HTTP_METHOD_" (enum.prefix)`,
+			},
+		},
+		{node: &ast.EnumItem{Name: "STATUS2_OK"}, ancestors: []ast.Node{&ast.Enum{Name: "status2"}}, want: []string{}},
+	}
+
+	check := checks.CheckEnumPrefix()
+	RunTests(t, &check, tests)
+}
+
+func TestCheckEnumSentinel(t *testing.T) {
+	tests := []Test{
+		{node: &ast.Enum{Name: "E"}, want: []string{}},
+		{
+			node: &ast.Enum{Name: "E", Items: []*ast.EnumItem{{Name: "UNKNOWN"}, {Name: "A"}}},
+			want: []string{},
+		},
+		{
+			node: &ast.Enum{Name: "E", Items: []*ast.EnumItem{{Name: "E_UNSPECIFIED", Value: intPtr(0)}}},
+			want: []string{},
+		},
+		{
+			node: &ast.Enum{Name: "E", Items: []*ast.EnumItem{{Name: "A", Value: intPtr(1)}}},
+			want: []string{
+				`t.thrift:0:1: warning: enumeration "E" must start with a sentinel item whose value is 0, not 1 (enum.sentinel)`,
+			},
+		},
+		{
+			node: &ast.Enum{Name: "E", Items: []*ast.EnumItem{{Name: "A"}}},
+			want: []string{
+				`t.thrift:0:1: warning: enumeration "E" must start with a sentinel item named one of ["UNKNOWN" "UNSPECIFIED"] (enum.sentinel)`,
+			},
+		},
+	}
+
+	check := checks.CheckEnumSentinel([]string{"UNKNOWN", "UNSPECIFIED"})
+	RunTests(t, &check, tests)
+
+	check = checks.CheckEnumSentinel(nil)
+	RunTests(t, &check, []Test{
+		{node: &ast.Enum{Name: "E", Items: []*ast.EnumItem{{Name: "A"}}}, want: []string{}},
+	})
+}
+
+func TestCheckEnumValueDuplicate(t *testing.T) {
+	a := &ast.EnumItem{Name: "A"}
+	b := &ast.EnumItem{Name: "B", Value: intPtr(2)}
+	c := &ast.EnumItem{Name: "C", Value: intPtr(1)}
+	d := &ast.EnumItem{Name: "D"}
+	e := &ast.Enum{Name: "E", Items: []*ast.EnumItem{a, b, c, d}}
+
+	tests := []Test{
+		{node: a, ancestors: []ast.Node{e}, want: []string{}},
+		{node: b, ancestors: []ast.Node{e}, want: []string{}},
+		{node: c, ancestors: []ast.Node{e}, want: []string{}},
+		{
+			node:      d,
+			ancestors: []ast.Node{e},
+			want: []string{
+				`t.thrift:0:1: error: enumeration item "D" (2) has the same value as "B" (enum.value.duplicate)`,
+			},
+		},
+	}
+
+	check := checks.CheckEnumValueDuplicate()
+	RunTests(t, &check, tests)
+}
+
+func TestCheckEnumValueMissing(t *testing.T) {
+	e := &ast.Enum{Name: "E"}
+
+	tests := []Test{
+		{node: &ast.EnumItem{Name: "A", Value: intPtr(0)}, ancestors: []ast.Node{e}, want: []string{}},
+		{
+			node:      &ast.EnumItem{Name: "B"},
+			ancestors: []ast.Node{e},
+			want: []string{
+				`t.thrift:0:1: error: enumeration item "B" (in "E") is missing an explicit value (enum.value.missing)`,
+			},
+		},
+	}
+
+	check := checks.CheckEnumValueMissing()
+	RunTests(t, &check, tests)
+}
+
+func TestCheckEnumValueNegative(t *testing.T) {
+	a := &ast.EnumItem{Name: "A", Value: intPtr(-2)}
+	b := &ast.EnumItem{Name: "B"}
+	c := &ast.EnumItem{Name: "C"}
+	e := &ast.Enum{Name: "E", Items: []*ast.EnumItem{a, b, c}}
+
+	tests := []Test{
+		{
+			node:      a,
+			ancestors: []ast.Node{e},
+			want: []string{
+				`t.thrift:0:1: error: enumeration item "A" (in "E") has a negative value (-2) (enum.value.negative)`,
+			},
+		},
+		{
+			node:      b,
+			ancestors: []ast.Node{e},
+			want: []string{
+				`t.thrift:0:1: error: enumeration item "B" (in "E") has a negative value (-1) (enum.value.negative)`,
+			},
+		},
+		{node: c, ancestors: []ast.Node{e}, want: []string{}},
+	}
+
+	check := checks.CheckEnumValueNegative()
+	RunTests(t, &check, tests)
+}
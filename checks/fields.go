@@ -15,17 +15,213 @@
 package checks
 
 import (
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/danwakefield/fnmatch"
 	"github.com/pinterest/thriftcheck"
 	"go.uber.org/thriftrw/ast"
 )
 
+// siblingFields returns the list of fields that contains f.
+func siblingFields(parent ast.Node, f *ast.Field) []*ast.Field {
+	switch p := parent.(type) {
+	case *ast.Struct:
+		return p.Fields
+	case *ast.Function:
+		if slices.Contains(p.Exceptions, f) {
+			return p.Exceptions
+		}
+		return p.Parameters
+	}
+	return nil
+}
+
+// nextFieldID returns the ID that should be assigned to f, which is missing
+// its ID. Fields without IDs are numbered in order after the highest
+// explicitly assigned ID.
+func nextFieldID(fields []*ast.Field, f *ast.Field) int {
+	id := 0
+	for _, sf := range fields {
+		if !sf.IDUnset {
+			id = max(id, sf.ID)
+		}
+	}
+	for _, sf := range fields {
+		if sf.IDUnset {
+			id++
+			if sf == f {
+				break
+			}
+		}
+	}
+	return id
+}
+
+// CheckFieldIDDuplicate reports an error if a field's ID is already used by
+// another field in the same struct, union, exception, parameter list, or
+// exception list.
+func CheckFieldIDDuplicate() thriftcheck.Check {
+	return thriftcheck.NewCheck("field.id.duplicate", func(c *thriftcheck.C, parent ast.Node, f *ast.Field) {
+		if f.IDUnset {
+			return
+		}
+		for _, sf := range siblingFields(parent, f) {
+			if sf == f {
+				return
+			}
+			if !sf.IDUnset && sf.ID == f.ID {
+				c.Errorf(f, "field ID for %q (%d) is already used by %q", f.Name, f.ID, sf.Name)
+				return
+			}
+		}
+	}).WithDescription("Field IDs must be unique")
+}
+
+// FieldIDRange is an inclusive range of field IDs.
+type FieldIDRange struct {
+	Min int
+	Max int
+}
+
+func (r FieldIDRange) String() string {
+	return fmt.Sprintf("[%d, %d]", r.Min, r.Max)
+}
+
+// withDefaults returns the range with a zero (unset) Min or Max replaced by the
+// default range of [1, 32767].
+func (r FieldIDRange) withDefaults() FieldIDRange {
+	if r.Min == 0 {
+		r.Min = 1
+	}
+	if r.Max == 0 {
+		r.Max = math.MaxInt16
+	}
+	return r
+}
+
+// CheckFieldIDRange reports an error if a field's ID falls outside of the
+// allowed range. Structs whose names match one of the name patterns in
+// structs must instead use IDs in the corresponding range; patterns are
+// tried in sorted order.
+//
+// A zero Min or Max in any of the ranges means that the bound is unset, and
+// the default of 1 or 32767 is used instead.
+//
+// Zero and negative IDs are ignored because they're reported by the
+// field.id.zero and field.id.negative checks.
+func CheckFieldIDRange(allowed FieldIDRange, structs map[string]FieldIDRange) thriftcheck.Check {
+	allowed = allowed.withDefaults()
+	patterns := slices.Sorted(maps.Keys(structs))
+
+	return thriftcheck.NewCheck("field.id.range", func(c *thriftcheck.C, parent ast.Node, f *ast.Field) {
+		if f.IDUnset || f.ID <= 0 {
+			return
+		}
+
+		r := allowed
+		if s, ok := parent.(*ast.Struct); ok {
+			for _, pattern := range patterns {
+				if fnmatch.Match(pattern, s.Name, fnmatch.FNM_NOESCAPE) {
+					r = structs[pattern].withDefaults()
+					break
+				}
+			}
+		}
+
+		if f.ID < r.Min || f.ID > r.Max {
+			c.Errorf(f, "field ID for %q (%d) is outside of the allowed range %s", f.Name, f.ID, r)
+		}
+	}).WithDescription("Field IDs must be within the allowed range")
+}
+
+// CheckFieldReserved reports an error if a field uses an ID or name that has
+// been reserved by its struct's `thriftcheck.reserved_ids` or
+// `thriftcheck.reserved_names` annotations. IDs are given as a comma-separated
+// list of IDs and inclusive ranges (e.g. "3,7-9"), and names as a
+// comma-separated list of names.
+//
+// The check runs on each of the struct's fields and annotations (rather than
+// the struct itself) so that they can be excluded using `nolint`.
+func CheckFieldReserved() thriftcheck.Check {
+	return thriftcheck.NewCheck("field.reserved", func(c *thriftcheck.C, s *ast.Struct, n ast.Node) {
+		switch n := n.(type) {
+		case *ast.Annotation:
+			if n.Name == "thriftcheck.reserved_ids" {
+				for _, value := range strings.Split(n.Value, ",") {
+					if _, err := parseFieldIDRange(strings.TrimSpace(value)); err != nil {
+						c.Errorf(n, "invalid reserved field ID %q", strings.TrimSpace(value))
+					}
+				}
+			}
+		case *ast.Field:
+			ranges, names := reservedFields(s)
+			if !n.IDUnset && slices.ContainsFunc(ranges, func(r FieldIDRange) bool { return n.ID >= r.Min && n.ID <= r.Max }) {
+				c.Errorf(n, "field ID for %q (%d) is reserved", n.Name, n.ID)
+			}
+			if names[n.Name] {
+				c.Errorf(n, "field name %q is reserved", n.Name)
+			}
+		}
+	}).WithDescription("Fields must not use reserved IDs or names")
+}
+
+// reservedFields returns the field ID ranges and names reserved by a struct's
+// annotations. Invalid IDs are ignored.
+func reservedFields(s *ast.Struct) (ranges []FieldIDRange, names map[string]bool) {
+	names = make(map[string]bool)
+	for _, annotation := range ast.Annotations(s) {
+		switch annotation.Name {
+		case "thriftcheck.reserved_ids":
+			for _, value := range strings.Split(annotation.Value, ",") {
+				if r, err := parseFieldIDRange(strings.TrimSpace(value)); err == nil {
+					ranges = append(ranges, r)
+				}
+			}
+		case "thriftcheck.reserved_names":
+			for _, name := range strings.Split(annotation.Value, ",") {
+				names[strings.TrimSpace(name)] = true
+			}
+		}
+	}
+	return ranges, names
+}
+
+// parseFieldIDRange parses a single field ID ("3") or an inclusive range of
+// field IDs ("7-9").
+func parseFieldIDRange(s string) (FieldIDRange, error) {
+	first, last, isRange := strings.Cut(s, "-")
+	lo, err := strconv.Atoi(first)
+	if err != nil {
+		return FieldIDRange{}, err
+	}
+	hi := lo
+	if isRange {
+		if hi, err = strconv.Atoi(last); err != nil {
+			return FieldIDRange{}, err
+		}
+		if hi < lo {
+			return FieldIDRange{}, fmt.Errorf("invalid range %q", s)
+		}
+	}
+	return FieldIDRange{Min: lo, Max: hi}, nil
+}
+
 // CheckFieldIDMissing reports an error if a field's ID is missing.
+//
+// The fix assigns the next free ID.
 func CheckFieldIDMissing() thriftcheck.Check {
-	return thriftcheck.NewCheck("field.id.missing", func(c *thriftcheck.C, f *ast.Field) {
+	return thriftcheck.NewCheck("field.id.missing", func(c *thriftcheck.C, parent ast.Node, f *ast.Field) {
 		if f.IDUnset {
-			c.Errorf(f, "field ID for %q is missing", f.Name)
+			id := nextFieldID(siblingFields(parent, f), f)
+			c.Errorf(f, "field ID for %q is missing", f.Name).
+				WithFix(c.InsertBefore(f, fmt.Sprintf("%d: ", id)))
 		}
-	})
+	}).WithDescription("Fields must have explicit IDs")
 }
 
 // CheckFieldIDNegative reports an error if a field's ID is explicitly negative.
@@ -34,7 +230,7 @@ func CheckFieldIDNegative() thriftcheck.Check {
 		if !f.IDUnset && f.ID < 0 {
 			c.Errorf(f, "field ID for %q (%d) is negative", f.Name, f.ID)
 		}
-	})
+	}).WithDescription("Field IDs must not be negative")
 }
 
 // CheckFieldIDZero reports an error if a field's ID is explicitly zero.
@@ -43,7 +239,7 @@ func CheckFieldIDZero() thriftcheck.Check {
 		if !f.IDUnset && f.ID == 0 {
 			c.Errorf(f, "field ID for %q is zero", f.Name)
 		}
-	})
+	}).WithDescription("Field IDs must not be zero")
 }
 
 // CheckFieldOptional warns if a field isn't declared as "optional".
@@ -52,16 +248,19 @@ func CheckFieldOptional() thriftcheck.Check {
 		if f.Requiredness != ast.Optional {
 			c.Warningf(f, `field %q (%d) should be "optional"`, f.Name, f.ID)
 		}
-	})
+	}).WithDescription("Fields should be declared optional")
 }
 
 // CheckFieldRequiredness warns if a field isn't explicitly declared as "required" or "optional".
+//
+// The fix declares the field "optional".
 func CheckFieldRequiredness() thriftcheck.Check {
 	return thriftcheck.NewCheck("field.requiredness", func(c *thriftcheck.C, f *ast.Field) {
 		if f.Requiredness == ast.Unspecified {
-			c.Warningf(f, `field %q (%d) should be explicitly "required" or "optional"`, f.Name, f.ID)
+			c.Warningf(f, `field %q (%d) should be explicitly "required" or "optional"`, f.Name, f.ID).
+				WithFix(c.InsertBefore(f.Type, "optional "))
 		}
-	})
+	}).WithDescription("Fields should be explicitly required or optional")
 }
 
 // CheckFieldDocMissing warns if a field is missing a documentation comment.
@@ -70,5 +269,5 @@ func CheckFieldDocMissing() thriftcheck.Check {
 		if f.Doc == "" {
 			c.Warningf(f, `field %q (%d) is missing a documentation comment`, f.Name, f.ID)
 		}
-	})
+	}).WithDescription("Fields should have documentation comments")
 }
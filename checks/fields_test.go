@@ -15,20 +15,215 @@
 package checks_test
 
 import (
+	"reflect"
 	"testing"
 
+	"github.com/pinterest/thriftcheck"
 	"github.com/pinterest/thriftcheck/checks"
 	"go.uber.org/thriftrw/ast"
 )
 
+func TestCheckFieldIDDuplicate(t *testing.T) {
+	a := &ast.Field{ID: 1, Name: "a"}
+	b := &ast.Field{ID: 2, Name: "b"}
+	c := &ast.Field{ID: 1, Name: "c"}
+	d := &ast.Field{IDUnset: true, Name: "d"}
+	e := &ast.Field{IDUnset: true, Name: "e"}
+	s := &ast.Struct{Fields: []*ast.Field{a, b, c, d, e}}
+
+	x := &ast.Field{ID: 1, Name: "x"}
+	y := &ast.Field{ID: 1, Name: "y"}
+	fn := &ast.Function{Parameters: []*ast.Field{x}, Exceptions: []*ast.Field{y}}
+
+	tests := []Test{
+		{node: a, ancestors: []ast.Node{s}, want: []string{}},
+		{node: b, ancestors: []ast.Node{s}, want: []string{}},
+		{
+			node:      c,
+			ancestors: []ast.Node{s},
+			want: []string{
+				`t.thrift:0:1: error: field ID for "c" (1) is already used by "a" (field.id.duplicate)`,
+			},
+		},
+		{node: e, ancestors: []ast.Node{s}, want: []string{}},
+		{node: x, ancestors: []ast.Node{fn}, want: []string{}},
+		{node: y, ancestors: []ast.Node{fn}, want: []string{}},
+	}
+
+	check := checks.CheckFieldIDDuplicate()
+	RunTests(t, &check, tests)
+}
+
+func TestCheckFieldIDRange(t *testing.T) {
+	s := &ast.Struct{Name: "S"}
+	legacy := &ast.Struct{Name: "LegacyS"}
+	fn := &ast.Function{Name: "f"}
+
+	tests := []Test{
+		{node: &ast.Field{ID: 1}, ancestors: []ast.Node{s}, want: []string{}},
+		{node: &ast.Field{ID: 32767}, ancestors: []ast.Node{s}, want: []string{}},
+		{node: &ast.Field{ID: 0}, ancestors: []ast.Node{s}, want: []string{}},
+		{node: &ast.Field{ID: -1}, ancestors: []ast.Node{s}, want: []string{}},
+		{node: &ast.Field{IDUnset: true}, ancestors: []ast.Node{s}, want: []string{}},
+		{
+			node:      &ast.Field{ID: 32768, Name: "a"},
+			ancestors: []ast.Node{s},
+			want: []string{
+				`t.thrift:0:1: error: field ID for "a" (32768) is outside of the allowed range [1, 32767] (field.id.range)`,
+			},
+		},
+		{
+			node:      &ast.Field{ID: 40000, Name: "a"},
+			ancestors: []ast.Node{fn},
+			want: []string{
+				`t.thrift:0:1: error: field ID for "a" (40000) is outside of the allowed range [1, 32767] (field.id.range)`,
+			},
+		},
+		{node: &ast.Field{ID: 1000}, ancestors: []ast.Node{legacy}, want: []string{}},
+		{
+			node:      &ast.Field{ID: 1, Name: "a"},
+			ancestors: []ast.Node{legacy},
+			want: []string{
+				`t.thrift:0:1: error: field ID for "a" (1) is outside of the allowed range [1000, 1999] (field.id.range)`,
+			},
+		},
+	}
+
+	check := checks.CheckFieldIDRange(
+		checks.FieldIDRange{Min: 1, Max: 32767},
+		map[string]checks.FieldIDRange{
+			"Legacy*": {Min: 1000, Max: 1999},
+			"Other":   {Min: 1, Max: 10},
+		})
+	RunTests(t, &check, tests)
+}
+
+func TestCheckFieldIDRangeDefaults(t *testing.T) {
+	s := &ast.Struct{Name: "S"}
+	legacy := &ast.Struct{Name: "Legacy"}
+
+	tests := []Test{
+		{node: &ast.Field{ID: 1, Name: "a"}, ancestors: []ast.Node{s}, want: []string{}},
+		{node: &ast.Field{ID: 32767, Name: "a"}, ancestors: []ast.Node{s}, want: []string{}},
+		{
+			node:      &ast.Field{ID: 32768, Name: "a"},
+			ancestors: []ast.Node{s},
+			want: []string{
+				`t.thrift:0:1: error: field ID for "a" (32768) is outside of the allowed range [1, 32767] (field.id.range)`,
+			},
+		},
+		{
+			node:      &ast.Field{ID: 1, Name: "a"},
+			ancestors: []ast.Node{legacy},
+			want: []string{
+				`t.thrift:0:1: error: field ID for "a" (1) is outside of the allowed range [1000, 32767] (field.id.range)`,
+			},
+		},
+	}
+
+	// This is the configuration used when there is no configuration file.
+	check := checks.CheckFieldIDRange(checks.FieldIDRange{}, nil)
+	RunTests(t, &check, tests[:3])
+
+	check = checks.CheckFieldIDRange(checks.FieldIDRange{}, map[string]checks.FieldIDRange{"Legacy": {Min: 1000}})
+	RunTests(t, &check, tests)
+}
+
+func TestCheckFieldReserved(t *testing.T) {
+	annotations := func(values ...string) []*ast.Annotation {
+		var annotations []*ast.Annotation
+		for i := 0; i < len(values); i += 2 {
+			annotations = append(annotations, &ast.Annotation{Name: values[i], Value: values[i+1], Line: 1})
+		}
+		return annotations
+	}
+	plain := &ast.Struct{}
+	reserved := &ast.Struct{Annotations: annotations(
+		"thriftcheck.reserved_ids", "3, 7-9",
+		"thriftcheck.reserved_names", "old_name,older_name",
+	)}
+	invalid := &ast.Struct{Annotations: annotations(
+		"thriftcheck.reserved_ids", "1",
+		"thriftcheck.reserved_ids", "x,9-7,10",
+	)}
+
+	tests := []Test{
+		{node: &ast.Field{ID: 3, Name: "b"}, ancestors: []ast.Node{plain}, want: []string{}},
+		{node: &ast.Field{ID: 1, Name: "a"}, ancestors: []ast.Node{reserved}, want: []string{}},
+		{node: &ast.Field{IDUnset: true, Name: "d"}, ancestors: []ast.Node{reserved}, want: []string{}},
+		{
+			node:      &ast.Field{ID: 3, Name: "b"},
+			ancestors: []ast.Node{reserved},
+			want: []string{
+				`t.thrift:0:1: error: field ID for "b" (3) is reserved (field.reserved)`,
+			},
+		},
+		{
+			node:      &ast.Field{ID: 8, Name: "c"},
+			ancestors: []ast.Node{reserved},
+			want: []string{
+				`t.thrift:0:1: error: field ID for "c" (8) is reserved (field.reserved)`,
+			},
+		},
+		{
+			node:      &ast.Field{ID: 10, Name: "old_name"},
+			ancestors: []ast.Node{reserved},
+			want: []string{
+				`t.thrift:0:1: error: field name "old_name" is reserved (field.reserved)`,
+			},
+		},
+		{node: reserved.Annotations[0], ancestors: []ast.Node{reserved}, want: []string{}},
+		{
+			node:      invalid.Annotations[1],
+			ancestors: []ast.Node{invalid},
+			want: []string{
+				`t.thrift:1:1: error: invalid reserved field ID "x" (field.reserved)`,
+				`t.thrift:1:1: error: invalid reserved field ID "9-7" (field.reserved)`,
+			},
+		},
+		{
+			node:      &ast.Field{ID: 10, Name: "old_name"},
+			ancestors: []ast.Node{invalid},
+			want: []string{
+				`t.thrift:0:1: error: field ID for "old_name" (10) is reserved (field.reserved)`,
+			},
+		},
+	}
+
+	check := checks.CheckFieldReserved()
+	RunTests(t, &check, tests)
+}
+
+func TestCheckFieldReservedNoLint(t *testing.T) {
+	tests := []ProjectTest{
+		{
+			files: map[string]string{
+				"a.thrift": "struct S {\n" +
+					"  1: optional string a\n" +
+					"  3: optional string b (nolint = \"field.reserved\")\n" +
+					"  4: optional string c\n" +
+					"} (thriftcheck.reserved_ids = \"3-4\")\n",
+			},
+			want: []string{
+				`a.thrift:4:3: error: field ID for "c" (4) is reserved (field.reserved)`,
+			},
+		},
+	}
+
+	check := checks.CheckFieldReserved()
+	RunProjectTests(t, &check, tests)
+}
+
 func TestCheckFieldIDMissing(t *testing.T) {
 	tests := []Test{
 		{
-			node: &ast.Field{ID: 1},
-			want: []string{},
+			node:      &ast.Field{ID: 1},
+			ancestors: []ast.Node{&ast.Struct{}},
+			want:      []string{},
 		},
 		{
-			node: &ast.Field{IDUnset: true},
+			node:      &ast.Field{IDUnset: true},
+			ancestors: []ast.Node{&ast.Struct{}},
 			want: []string{
 				`t.thrift:0:1: error: field ID for "" is missing (field.id.missing)`,
 			},
@@ -39,6 +234,30 @@ func TestCheckFieldIDMissing(t *testing.T) {
 	RunTests(t, &check, tests)
 }
 
+func TestCheckFieldIDMissingFix(t *testing.T) {
+	a := &ast.Field{IDUnset: true, Line: 2, Column: 3}
+	b := &ast.Field{ID: 3, Line: 3, Column: 3}
+	c := &ast.Field{IDUnset: true, Line: 4, Column: 3}
+	s := &ast.Struct{Fields: []*ast.Field{a, b, c}}
+
+	tests := []struct {
+		field *ast.Field
+		want  thriftcheck.Edit
+	}{
+		{a, thriftcheck.Edit{Pos: ast.Position{Line: 2, Column: 3}, End: ast.Position{Line: 2, Column: 3}, Text: "4: "}},
+		{c, thriftcheck.Edit{Pos: ast.Position{Line: 4, Column: 3}, End: ast.Position{Line: 4, Column: 3}, Text: "5: "}},
+	}
+
+	check := checks.CheckFieldIDMissing()
+	for _, tt := range tests {
+		ctx := &thriftcheck.C{Filename: "t.thrift"}
+		check.Call(ctx, tt.field, s)
+		if len(ctx.Messages) != 1 || !reflect.DeepEqual(ctx.Messages[0].Fix, []thriftcheck.Edit{tt.want}) {
+			t.Errorf("expected fix %v, got %v", tt.want, ctx.Messages)
+		}
+	}
+}
+
 func TestCheckFieldIDNegative(t *testing.T) {
 	tests := []Test{
 		{
@@ -137,6 +356,20 @@ func TestCheckFieldRequiredness(t *testing.T) {
 	RunTests(t, &check, tests)
 }
 
+func TestCheckFieldRequirednessFix(t *testing.T) {
+	f := &ast.Field{ID: 1, Name: "Field", Type: ast.BaseType{ID: ast.StringTypeID, Line: 2, Column: 6}}
+	want := []thriftcheck.Edit{
+		{Pos: ast.Position{Line: 2, Column: 6}, End: ast.Position{Line: 2, Column: 6}, Text: "optional "},
+	}
+
+	check := checks.CheckFieldRequiredness()
+	ctx := &thriftcheck.C{Filename: "t.thrift"}
+	check.Call(ctx, f)
+	if len(ctx.Messages) != 1 || !reflect.DeepEqual(ctx.Messages[0].Fix, want) {
+		t.Errorf("expected fix %v, got %v", want, ctx.Messages)
+	}
+}
+
 func TestCheckFieldDocMissing(t *testing.T) {
 	tests := []Test{
 		{
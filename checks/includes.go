@@ -18,12 +18,69 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/danwakefield/fnmatch"
 	"github.com/pinterest/thriftcheck"
 	"go.uber.org/thriftrw/ast"
 )
 
+// CheckIncludeCycle returns a thriftcheck.Check that reports circular include
+// paths (e.g. a.thrift -> b.thrift -> a.thrift). Cycles are reported at the
+// `include` that completes them, along with the full path of the cycle.
+func CheckIncludeCycle() thriftcheck.Check {
+	return thriftcheck.NewProjectCheck("include.cycle", func(p *thriftcheck.P) {
+		const (
+			unvisited = iota
+			visiting
+			visited
+		)
+		state := make(map[string]int)
+		var stack []string
+
+		var visit func(filename string)
+		visit = func(filename string) {
+			state[filename] = visiting
+			stack = append(stack, filename)
+
+			if program := p.Program(filename); program != nil {
+				for _, header := range program.Headers {
+					include, ok := header.(*ast.Include)
+					if !ok {
+						continue
+					}
+					path, ok := p.Locate(filename, include)
+					if !ok {
+						continue
+					}
+
+					switch state[path] {
+					case unvisited:
+						visit(path)
+					case visiting:
+						var names []string
+						for _, f := range stack[slices.Index(stack, path):] {
+							names = append(names, p.Name(f))
+						}
+						names = append(names, p.Name(path))
+						p.Errorf(filename, include, "include cycle: %s", strings.Join(names, " -> "))
+					}
+				}
+			}
+
+			stack = stack[:len(stack)-1]
+			state[filename] = visited
+		}
+
+		for _, filename := range p.Files {
+			if state[filename] == unvisited {
+				visit(filename)
+			}
+		}
+	}).WithDescription("Files must not include themselves, directly or indirectly")
+}
+
 // CheckIncludePath returns a thriftcheck.Check that verifies that all of the
 // files `include`'d by a Thrift file can be found in the includes paths.
 func CheckIncludePath() thriftcheck.Check {
@@ -47,7 +104,47 @@ func CheckIncludePath() thriftcheck.Check {
 		if !found {
 			c.Errorf(i, "unable to find include file %q", i.Path)
 		}
-	})
+	}).WithDescription("Included files must be found in the include paths")
+}
+
+// CheckIncludeUnused returns a thriftcheck.Check that reports `include`s that
+// are never referenced. An include is referenced when its file prefix (the
+// `foo` in `foo.thrift`) qualifies a type, constant, or service reference.
+func CheckIncludeUnused() thriftcheck.Check {
+	return thriftcheck.NewCheck("include.unused", func(c *thriftcheck.C, p *ast.Program) {
+		prefixes := referencedPrefixes(p)
+		for _, header := range p.Headers {
+			if i, ok := header.(*ast.Include); ok && !prefixes[thriftcheck.IncludeName(i)] {
+				c.Warningf(i, "%q is included but never used", i.Path)
+			}
+		}
+	}).WithDescription("Included files must be referenced")
+}
+
+// referencedPrefixes returns the set of include prefixes used to qualify the
+// type, constant, and service references in a program.
+func referencedPrefixes(p *ast.Program) map[string]bool {
+	prefixes := make(map[string]bool)
+	add := func(name string) {
+		if prefix, _, ok := strings.Cut(name, "."); ok {
+			prefixes[prefix] = true
+		}
+	}
+
+	ast.Walk(ast.VisitorFunc(func(_ ast.Walker, n ast.Node) {
+		switch n := n.(type) {
+		case ast.TypeReference:
+			add(n.Name)
+		case ast.ConstantReference:
+			add(n.Name)
+		case *ast.Service:
+			if n.Parent != nil {
+				add(n.Parent.Name)
+			}
+		}
+	}), p)
+
+	return prefixes
 }
 
 // CheckIncludeRestricted returns a thriftcheck.Check that restricts some files
@@ -64,5 +161,5 @@ func CheckIncludeRestricted(patterns map[string]*regexp.Regexp) thriftcheck.Chec
 				return
 			}
 		}
-	})
+	}).WithDescription("Restricted files must not be included")
 }
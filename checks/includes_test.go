@@ -16,8 +16,10 @@ package checks_test
 
 import (
 	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/pinterest/thriftcheck"
 	"github.com/pinterest/thriftcheck/checks"
 	"go.uber.org/thriftrw/ast"
 )
@@ -85,3 +87,100 @@ func TestCheckIncludeRestricted(t *testing.T) {
 	})
 	RunTests(t, &check, tests)
 }
+
+func TestCheckIncludeCycle(t *testing.T) {
+	tests := []ProjectTest{
+		{
+			files: map[string]string{
+				"a.thrift": `include "b.thrift"`,
+				"b.thrift": `include "c.thrift"`,
+				"c.thrift": `struct C {}`,
+			},
+			want: []string{},
+		},
+		{
+			files: map[string]string{
+				"a.thrift": `include "a.thrift"`,
+			},
+			want: []string{
+				`a.thrift:1:1: error: include cycle: a.thrift -> a.thrift (include.cycle)`,
+			},
+		},
+		{
+			files: map[string]string{
+				"a.thrift":        "include \"b.thrift\"\n",
+				"b.thrift":        "include \"shared/c.thrift\"\n",
+				"shared/c.thrift": "include \"../a.thrift\"\n",
+			},
+			lint: []string{"a.thrift"},
+			want: []string{
+				`shared/c.thrift:1:1: error: include cycle: a.thrift -> b.thrift -> shared/c.thrift -> a.thrift (include.cycle)`,
+			},
+		},
+		{
+			files: map[string]string{
+				"a.thrift": "include \"b.thrift\"\ninclude \"c.thrift\"\n",
+				"b.thrift": "include \"a.thrift\"\n",
+				"c.thrift": "include \"b.thrift\"\n",
+			},
+			lint: []string{"a.thrift"},
+			want: []string{
+				`b.thrift:1:1: error: include cycle: a.thrift -> b.thrift -> a.thrift (include.cycle)`,
+			},
+		},
+	}
+
+	check := checks.CheckIncludeCycle()
+	RunProjectTests(t, &check, tests)
+}
+
+func TestCheckIncludeUnused(t *testing.T) {
+	parse := func(s string) *ast.Program {
+		t.Helper()
+		prog, _, err := thriftcheck.Parse(strings.NewReader(s))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return prog
+	}
+
+	types := parse(`
+include "shared/types.thrift"
+include "unused.thrift"
+struct S { 1: optional types.T t }
+`)
+	constants := parse(`
+include "constants.thrift"
+const i32 X = constants.Y
+`)
+	services := parse(`
+include "base.thrift"
+service S extends base.Base {}
+`)
+	named := parse(`
+include t "types.thrift"
+include u "other.thrift"
+typedef t.T T
+typedef other.O O
+`)
+
+	tests := []Test{
+		{
+			node: types,
+			want: []string{
+				`t.thrift:3:1: warning: "unused.thrift" is included but never used (include.unused)`,
+			},
+		},
+		{node: constants, want: []string{}},
+		{node: services, want: []string{}},
+		{
+			node: named,
+			want: []string{
+				`t.thrift:3:1: warning: "other.thrift" is included but never used (include.unused)`,
+			},
+		},
+	}
+
+	check := checks.CheckIncludeUnused()
+	RunTests(t, &check, tests)
+}
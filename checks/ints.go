@@ -27,5 +27,34 @@ func CheckInteger64bit() thriftcheck.Check {
 		if i < math.MinInt32 || i > math.MaxInt32 {
 			c.Warningf(i, "64-bit integer constant %d may not work in all languages", i)
 		}
-	})
+	}).WithDescription("Integer constants should fit in 32 bits")
+}
+
+// CheckIntegerRange returns a thriftcheck.Check that reports an error if an
+// integer doesn't fit in its declared type. This covers constant values, field
+// default values (including those in containers and structs), and enumeration
+// values, which must fit in an i32.
+func CheckIntegerRange() thriftcheck.Check {
+	return thriftcheck.NewCheck("int.range", func(c *thriftcheck.C, n ast.Node) {
+		vc := valueChecker{c: c, ranges: true}
+		switch n := n.(type) {
+		case *ast.Constant:
+			if reason := vc.check(n.Type, c.Filename, n.Value, c.Filename, 0); reason != "" {
+				c.Errorf(n, "value of constant %q: %s", n.Name, reason)
+			}
+		case *ast.Field:
+			if n.Default == nil {
+				return
+			}
+			if reason := vc.check(n.Type, c.Filename, n.Default, c.Filename, 0); reason != "" {
+				c.Errorf(n, "default value of field %q (%d): %s", n.Name, n.ID, reason)
+			}
+		case *ast.Enum:
+			for i, value := range enumValues(n) {
+				if value < math.MinInt32 || value > math.MaxInt32 {
+					c.Errorf(n.Items[i], "value of enum item %q: %d is out of range for i32", n.Items[i].Name, value)
+				}
+			}
+		}
+	}).WithDescription("Integers must fit in their declared types")
 }
@@ -45,3 +45,125 @@ func TestCheckInteger64bit(t *testing.T) {
 	check := checks.CheckInteger64bit()
 	RunTests(t, &check, tests)
 }
+
+func TestCheckIntegerRange(t *testing.T) {
+	prog := program(
+		&ast.Typedef{Name: "Small", Type: ast.BaseType{ID: ast.I16TypeID}},
+		&ast.Struct{Name: "S", Fields: []*ast.Field{
+			{ID: 1, Name: "a", Type: ast.BaseType{ID: ast.I8TypeID}},
+		}},
+	)
+
+	tests := []Test{
+		{
+			prog: prog,
+			node: &ast.Constant{Name: "C", Type: ast.BaseType{ID: ast.I8TypeID}, Value: ast.ConstantInteger(127)},
+			want: []string{},
+		},
+		{
+			prog: prog,
+			node: &ast.Constant{Name: "C", Type: ast.BaseType{ID: ast.I8TypeID}, Value: ast.ConstantInteger(300)},
+			want: []string{
+				`t.thrift:0:1: error: value of constant "C": 300 is out of range for i8 (int.range)`,
+			},
+		},
+		{
+			prog: prog,
+			node: &ast.Constant{Name: "C", Type: ast.TypeReference{Name: "Small"}, Value: ast.ConstantInteger(-32769)},
+			want: []string{
+				`t.thrift:0:1: error: value of constant "C": -32769 is out of range for i16 (int.range)`,
+			},
+		},
+		{
+			prog: prog,
+			node: &ast.Constant{Name: "C", Type: i32Type, Value: ast.ConstantString("abc")},
+			want: []string{},
+		},
+		{
+			prog: prog,
+			node: &ast.Constant{Name: "C", Type: ast.ListType{ValueType: i32Type}, Value: ast.ConstantList{Items: []ast.ConstantValue{
+				ast.ConstantString("abc"),
+				ast.ConstantInteger(math.MaxInt32 + 1),
+			}}},
+			want: []string{
+				`t.thrift:0:1: error: value of constant "C": item 1: 2147483648 is out of range for i32 (int.range)`,
+			},
+		},
+		{
+			prog: prog,
+			node: &ast.Constant{Name: "C", Type: ast.TypeReference{Name: "S"}, Value: ast.ConstantMap{Items: []ast.ConstantMapItem{
+				{Key: ast.ConstantString("b"), Value: ast.ConstantInteger(1000)},
+				{Key: ast.ConstantString("a"), Value: ast.ConstantInteger(1000)},
+			}}},
+			want: []string{
+				`t.thrift:0:1: error: value of constant "C": field "a": 1000 is out of range for i8 (int.range)`,
+			},
+		},
+		{
+			prog: prog,
+			node: &ast.Field{ID: 1, Name: "f", Type: ast.BaseType{ID: ast.I16TypeID}, Default: ast.ConstantInteger(70000)},
+			want: []string{
+				`t.thrift:0:1: error: default value of field "f" (1): 70000 is out of range for i16 (int.range)`,
+			},
+		},
+		{
+			prog: prog,
+			node: &ast.Field{ID: 1, Name: "f", Type: ast.BaseType{ID: ast.I16TypeID}},
+			want: []string{},
+		},
+		{
+			prog: prog,
+			node: &ast.Enum{Name: "E", Items: []*ast.EnumItem{
+				{Name: "A", Value: intPtr(math.MaxInt32)},
+				{Name: "B"},
+			}},
+			want: []string{
+				`t.thrift:0:1: error: value of enum item "B": 2147483648 is out of range for i32 (int.range)`,
+			},
+		},
+	}
+
+	check := checks.CheckIntegerRange()
+	RunTests(t, &check, tests)
+}
+
+func TestCheckIntegerRangeIncludes(t *testing.T) {
+	tests := []ProjectTest{
+		{
+			files: map[string]string{
+				"a.thrift": "include \"inc/b.thrift\"\n" +
+					"const b.Tiny X = 5\n" +
+					"const b.Tiny Y = 300\n" +
+					"const i8 Z = b.LIMIT\n",
+				"inc/b.thrift": "typedef Small Tiny\n" +
+					"typedef i8 Small\n" +
+					"const i16 LIMIT = 500\n",
+			},
+			lint: []string{"a.thrift"},
+			want: []string{
+				`a.thrift:3:1: error: value of constant "Y": 300 is out of range for i8 (int.range)`,
+				`a.thrift:4:1: error: value of constant "Z": 500 is out of range for i8 (int.range)`,
+			},
+		},
+		{
+			files: map[string]string{
+				"a.thrift": "include \"inc/b.thrift\"\n" +
+					"const b.BSmall X = 300\n" +
+					"const i8 Y = b.LIMIT\n",
+				"inc/b.thrift": "include \"c.thrift\"\n" +
+					"typedef c.Small BSmall\n" +
+					"const i16 LIMIT = c.LIMIT\n",
+				"inc/c.thrift": "typedef i8 Small\n" +
+					"const i16 LIMIT = 500\n",
+			},
+			lint: []string{"a.thrift"},
+			want: []string{
+				`a.thrift:2:1: error: value of constant "X": 300 is out of range for i8 (int.range)`,
+				`a.thrift:3:1: error: value of constant "Y": 500 is out of range for i8 (int.range)`,
+			},
+		},
+	}
+
+	check := checks.CheckIntegerRange()
+	RunProjectTests(t, &check, tests)
+}
@@ -0,0 +1,90 @@
+// Copyright 2025 Pinterest
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checks
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Language is a code generation target language whose reserved keywords can
+// be checked by CheckNamesReserved.
+type Language string
+
+// keywords are the reserved keywords of each supported language. These are the
+// words that can't be used as identifiers; contextual keywords, which are only
+// reserved in some positions, are not included.
+var keywords = map[Language][]string{
+	"cpp": strings.Fields(`
+		alignas alignof and and_eq asm auto bitand bitor bool break case catch
+		char char8_t char16_t char32_t class compl concept const consteval
+		constexpr constinit const_cast continue co_await co_return co_yield
+		decltype default delete do double dynamic_cast else enum explicit
+		export extern false float for friend goto if inline int long mutable
+		namespace new noexcept not not_eq nullptr operator or or_eq private
+		protected public register reinterpret_cast requires return short
+		signed sizeof static static_assert static_cast struct switch template
+		this thread_local throw true try typedef typeid typename union unsigned
+		using virtual void volatile wchar_t while xor xor_eq`),
+	"go": strings.Fields(`
+		break case chan const continue default defer else fallthrough for func
+		go goto if import interface map package range return select struct
+		switch type var`),
+	"java": strings.Fields(`
+		abstract assert boolean break byte case catch char class const continue
+		default do double else enum extends false final finally float for goto
+		if implements import instanceof int interface long native new null
+		package private protected public return short static strictfp super
+		switch synchronized this throw throws transient true try void volatile
+		while _`),
+	"js": strings.Fields(`
+		await break case catch class const continue debugger default delete do
+		else enum export extends false finally for function if implements
+		import in instanceof interface let new null package private protected
+		public return static super switch this throw true try typeof var void
+		while with yield`),
+	"kotlin": strings.Fields(`
+		as break class continue do else false for fun if in interface is null
+		object package return super this throw true try typealias typeof val
+		var when while`),
+	"py": strings.Fields(`
+		False None True and as assert async await break class continue def del
+		elif else except finally for from global if import in is lambda
+		nonlocal not or pass raise return try while with yield`),
+	"rs": strings.Fields(`
+		as async await break const continue crate dyn else enum extern false fn
+		for if impl in let loop match mod move mut pub ref return self Self
+		static struct super trait true type unsafe use where while abstract
+		become box do final gen macro override priv try typeof unsized virtual
+		yield`),
+	"swift": strings.Fields(`
+		associatedtype class deinit enum extension fileprivate func import init
+		inout internal let open operator private precedencegroup protocol
+		public rethrows static struct subscript typealias var break case catch
+		continue default defer do else fallthrough for guard if in repeat
+		return throw switch where while Any as await false is nil self Self
+		super throws true try`),
+}
+
+// UnmarshalString implements fig.StringUnmarshaler for automatic toml parsing.
+func (l *Language) UnmarshalString(name string) error {
+	if _, ok := keywords[Language(name)]; !ok {
+		return fmt.Errorf("unknown language: %s, valid languages are: %v", name, slices.Sorted(maps.Keys(keywords)))
+	}
+	*l = Language(name)
+	return nil
+}
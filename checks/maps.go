@@ -26,7 +26,7 @@ func CheckMapKeyType(allowedTypes, disallowedTypes []thriftcheck.ThriftType) thr
 		if ok, name := c.IsTypeAllowed(mt.KeyType, allowedTypes, disallowedTypes); !ok {
 			c.Errorf(mt, "map key type %q is not allowed", name)
 		}
-	})
+	}).WithDescription("Map key types must be allowed")
 }
 
 // CheckMapKeyType returns a thriftcheck.Check that checks if a `map<>` value
@@ -36,5 +36,5 @@ func CheckMapValueType(allowedTypes, disallowedTypes []thriftcheck.ThriftType) t
 		if ok, name := c.IsTypeAllowed(mt.ValueType, allowedTypes, disallowedTypes); !ok {
 			c.Errorf(mt, "map value type %q is not allowed", name)
 		}
-	})
+	}).WithDescription("Map value types must be allowed")
 }
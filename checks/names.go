@@ -15,7 +15,12 @@
 package checks
 
 import (
+	"fmt"
+	"maps"
 	"reflect"
+	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/pinterest/thriftcheck"
 	"go.uber.org/thriftrw/ast"
@@ -31,16 +36,122 @@ func nodeName(node ast.Node) string {
 	return ""
 }
 
-// CheckNamesReserved checks if a node's name is in the list of reserved names.
-func CheckNamesReserved(names []string) thriftcheck.Check {
+// CheckNamesReserved checks if a node's name is in the list of reserved names
+// or is a reserved keyword in any of the given languages.
+func CheckNamesReserved(names []string, languages []Language) thriftcheck.Check {
 	reserved := make(map[string]bool)
 	for _, name := range names {
 		reserved[name] = true
 	}
 
+	reservedIn := make(map[string][]string)
+	for _, language := range slices.Sorted(slices.Values(languages)) {
+		for _, keyword := range keywords[language] {
+			if !slices.Contains(reservedIn[keyword], string(language)) {
+				reservedIn[keyword] = append(reservedIn[keyword], string(language))
+			}
+		}
+	}
+
 	return thriftcheck.NewCheck("names.reserved", func(c *thriftcheck.C, n ast.Node) {
-		if name := nodeName(n); name != "" && reserved[name] {
+		name := nodeName(n)
+		if name == "" {
+			return
+		}
+		if reserved[name] {
 			c.Errorf(n, "%q is a reserved name", name)
+		} else if languages := reservedIn[name]; len(languages) > 0 {
+			c.Errorf(n, "%q is a reserved keyword in %s", name, strings.Join(languages, ", "))
+		}
+	}).WithDescription("Names must not be reserved")
+}
+
+// NameStyle is a naming convention. It's either one of the built-in styles
+// (PascalCase, camelCase, snake_case, or UPPER_SNAKE_CASE) or a custom regular
+// expression. The zero value matches all names.
+type NameStyle struct {
+	name string
+	re   *regexp.Regexp
+}
+
+var nameStyles = map[string]*regexp.Regexp{
+	"PascalCase":       regexp.MustCompile(`^[A-Z][a-zA-Z0-9]*$`),
+	"camelCase":        regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`),
+	"snake_case":       regexp.MustCompile(`^[a-z][a-z0-9]*(_[a-z0-9]+)*$`),
+	"UPPER_SNAKE_CASE": regexp.MustCompile(`^[A-Z][A-Z0-9]*(_[A-Z0-9]+)*$`),
+}
+
+// UnmarshalString implements fig.StringUnmarshaler for automatic toml parsing.
+// Values that aren't the name of a built-in style are parsed as regular
+// expressions.
+func (s *NameStyle) UnmarshalString(v string) error {
+	if re, ok := nameStyles[v]; ok {
+		s.name, s.re = v, re
+		return nil
+	}
+
+	re, err := regexp.Compile(v)
+	if err != nil {
+		return fmt.Errorf("invalid name style %q: must be one of %v or a regular expression", v, slices.Sorted(maps.Keys(nameStyles)))
+	}
+	s.name, s.re = v, re
+	return nil
+}
+
+func (s NameStyle) String() string {
+	return s.name
+}
+
+// Matches reports whether name matches this style.
+func (s NameStyle) Matches(name string) bool {
+	return s.re == nil || s.re.MatchString(name)
+}
+
+// NameStyles are the naming conventions for each kind of node. Kinds with a
+// zero NameStyle aren't checked.
+type NameStyles struct {
+	Struct    NameStyle
+	Union     NameStyle
+	Exception NameStyle
+	Enum      NameStyle
+	EnumItem  NameStyle
+	Service   NameStyle
+	Function  NameStyle
+	Field     NameStyle
+	Constant  NameStyle
+	Typedef   NameStyle
+}
+
+// lookup returns the kind of a node and its NameStyle.
+func (s NameStyles) lookup(node ast.Node) (string, NameStyle) {
+	switch n := node.(type) {
+	case *ast.Struct:
+		return structureKind(n.Type), byStructureType(n.Type, s.Struct, s.Union, s.Exception)
+	case *ast.Enum:
+		return "enum", s.Enum
+	case *ast.EnumItem:
+		return "enum item", s.EnumItem
+	case *ast.Service:
+		return "service", s.Service
+	case *ast.Function:
+		return "function", s.Function
+	case *ast.Field:
+		return "field", s.Field
+	case *ast.Constant:
+		return "constant", s.Constant
+	case *ast.Typedef:
+		return "typedef", s.Typedef
+	}
+	return "", NameStyle{}
+}
+
+// CheckNamesStyle checks that names follow the naming convention configured
+// for their kind of node.
+func CheckNamesStyle(styles NameStyles) thriftcheck.Check {
+	return thriftcheck.NewCheck("names.style", func(c *thriftcheck.C, n ast.Node) {
+		kind, style := styles.lookup(n)
+		if name := nodeName(n); name != "" && !style.Matches(name) {
+			c.Errorf(n, "%s name %q does not match style %q", kind, name, style)
 		}
-	})
+	}).WithDescription("Names must follow the naming convention for their kind")
 }
@@ -45,6 +45,148 @@ func TestCheckNamesReserved(t *testing.T) {
 		},
 	}
 
-	check := checks.CheckNamesReserved([]string{"reserved"})
+	check := checks.CheckNamesReserved([]string{"reserved"}, nil)
 	RunTests(t, &check, tests)
 }
+
+func TestCheckNamesReservedLanguages(t *testing.T) {
+	tests := []Test{
+		{
+			node: &ast.Struct{Name: "User"},
+			want: []string{},
+		},
+		{
+			node: &ast.Field{Name: "def"},
+			want: []string{
+				`t.thrift:0:1: error: "def" is a reserved keyword in py (names.reserved)`,
+			},
+		},
+		{
+			node: &ast.Field{Name: "import"},
+			want: []string{
+				`t.thrift:0:1: error: "import" is a reserved keyword in go, java, py (names.reserved)`,
+			},
+		},
+		{
+			node: &ast.Field{Name: "fn"},
+			want: []string{},
+		},
+		{
+			node: &ast.Field{Name: "template"},
+			want: []string{
+				`t.thrift:0:1: error: "template" is a reserved name (names.reserved)`,
+			},
+		},
+	}
+
+	check := checks.CheckNamesReserved([]string{"template"}, []checks.Language{"py", "java", "go"})
+	RunTests(t, &check, tests)
+}
+
+func TestLanguage(t *testing.T) {
+	var l checks.Language
+	if err := l.UnmarshalString("py"); err != nil || l != "py" {
+		t.Errorf("expected py, got %q (%v)", l, err)
+	}
+	if err := l.UnmarshalString("python"); err == nil {
+		t.Error("expected an error for an unknown language")
+	}
+}
+
+func TestCheckNamesStyle(t *testing.T) {
+	style := func(s string) checks.NameStyle {
+		t.Helper()
+		var style checks.NameStyle
+		if err := style.UnmarshalString(s); err != nil {
+			t.Fatal(err)
+		}
+		return style
+	}
+
+	tests := []Test{
+		{node: &ast.Struct{Name: "MyStruct"}, want: []string{}},
+		{
+			node: &ast.Struct{Name: "myStruct"},
+			want: []string{
+				`t.thrift:0:1: error: struct name "myStruct" does not match style "PascalCase" (names.style)`,
+			},
+		},
+		{node: &ast.Struct{Name: "my_union", Type: ast.UnionType}, want: []string{}},
+		{
+			node: &ast.Struct{Name: "MyError", Type: ast.ExceptionType},
+			want: []string{
+				`t.thrift:0:1: error: exception name "MyError" does not match style "^[A-Z][a-zA-Z]*Exception$" (names.style)`,
+			},
+		},
+		{node: &ast.EnumItem{Name: "FIRST_VALUE"}, want: []string{}},
+		{
+			node: &ast.EnumItem{Name: "firstValue"},
+			want: []string{
+				`t.thrift:0:1: error: enum item name "firstValue" does not match style "UPPER_SNAKE_CASE" (names.style)`,
+			},
+		},
+		{node: &ast.Function{Name: "getUser"}, want: []string{}},
+		{
+			node: &ast.Function{Name: "get_user"},
+			want: []string{
+				`t.thrift:0:1: error: function name "get_user" does not match style "camelCase" (names.style)`,
+			},
+		},
+		{node: &ast.Field{Name: "user_id"}, want: []string{}},
+		{
+			node: &ast.Field{Name: "userId"},
+			want: []string{
+				`t.thrift:0:1: error: field name "userId" does not match style "snake_case" (names.style)`,
+			},
+		},
+		{node: &ast.Enum{Name: "any_name"}, want: []string{}},
+	}
+
+	check := checks.CheckNamesStyle(checks.NameStyles{
+		Struct:    style("PascalCase"),
+		Exception: style("^[A-Z][a-zA-Z]*Exception$"),
+		EnumItem:  style("UPPER_SNAKE_CASE"),
+		Function:  style("camelCase"),
+		Field:     style("snake_case"),
+	})
+	RunTests(t, &check, tests)
+}
+
+func TestNameStyle(t *testing.T) {
+	tests := []struct {
+		style string
+		name  string
+		want  bool
+	}{
+		{"PascalCase", "PascalCase", true},
+		{"PascalCase", "HTTPServer", true},
+		{"PascalCase", "camelCase", false},
+		{"PascalCase", "Snake_Case", false},
+		{"camelCase", "camelCase", true},
+		{"camelCase", "PascalCase", false},
+		{"snake_case", "snake_case_2", true},
+		{"snake_case", "snake__case", false},
+		{"snake_case", "Snake_case", false},
+		{"UPPER_SNAKE_CASE", "UPPER_SNAKE", true},
+		{"UPPER_SNAKE_CASE", "UPPER_snake", false},
+		{"^x", "xyz", true},
+	}
+
+	for _, tt := range tests {
+		var style checks.NameStyle
+		if err := style.UnmarshalString(tt.style); err != nil {
+			t.Fatal(err)
+		}
+		if got := style.Matches(tt.name); got != tt.want {
+			t.Errorf("%s.Matches(%q) = %v, want %v", tt.style, tt.name, got, tt.want)
+		}
+	}
+
+	var style checks.NameStyle
+	if err := style.UnmarshalString("("); err == nil {
+		t.Error("expected an error for an invalid regular expression")
+	}
+	if !style.Matches("anything") {
+		t.Error("expected the zero style to match all names")
+	}
+}
@@ -16,6 +16,7 @@ package checks
 
 import (
 	"regexp"
+	"slices"
 
 	"github.com/pinterest/thriftcheck"
 	"go.uber.org/thriftrw/ast"
@@ -29,5 +30,114 @@ func CheckNamespacePattern(patterns map[string]*regexp.Regexp) thriftcheck.Check
 		if re, ok := patterns[ns.Scope]; ok && !re.MatchString(ns.Name) {
 			c.Errorf(ns, "%q namespace must match %q", ns.Scope, re)
 		}
-	})
+	}).WithDescription("Namespaces must match their configured patterns")
+}
+
+// CheckNamespaceCollision returns a thriftcheck.Check that reports an error if
+// a type in one of the linted files has the same name as a type in another
+// file that declares the same namespace, because the generated code for the
+// two types would collide. Types are structs, unions, exceptions, enums,
+// typedefs, and services. The linted files and the files they include are all
+// compared.
+func CheckNamespaceCollision() thriftcheck.Check {
+	return thriftcheck.NewProjectCheck("namespace.collision", func(p *thriftcheck.P) {
+		type key struct{ scope, namespace, name string }
+		files := make(map[key][]string)
+
+		isType := func(def ast.Definition) bool {
+			switch def.(type) {
+			case *ast.Struct, *ast.Enum, *ast.Typedef, *ast.Service:
+				return true
+			}
+			return false
+		}
+
+		for _, filename := range p.Graph.Files() {
+			program := p.Program(filename)
+			if program == nil {
+				continue
+			}
+			for _, header := range program.Headers {
+				ns, ok := header.(*ast.Namespace)
+				if !ok {
+					continue
+				}
+				for _, def := range program.Definitions {
+					if k := (key{ns.Scope, ns.Name, def.Info().Name}); isType(def) && !slices.Contains(files[k], filename) {
+						files[k] = append(files[k], filename)
+					}
+				}
+			}
+		}
+
+		for _, filename := range p.Files {
+			program := p.Program(filename)
+			if program == nil {
+				continue
+			}
+			for _, header := range program.Headers {
+				ns, ok := header.(*ast.Namespace)
+				if !ok {
+					continue
+				}
+				for _, def := range program.Definitions {
+					if !isType(def) {
+						continue
+					}
+					for _, other := range files[key{ns.Scope, ns.Name, def.Info().Name}] {
+						if other != filename {
+							p.Errorf(filename, def, "type %q in %q namespace %q is also defined in %q", def.Info().Name, ns.Scope, ns.Name, p.Name(other))
+							break
+						}
+					}
+				}
+			}
+		}
+	}).WithDescription("Types must not collide with types in the same namespace")
+}
+
+// CheckNamespaceDuplicate returns a thriftcheck.Check that reports an error if
+// a file declares more than one namespace for the same scope.
+func CheckNamespaceDuplicate() thriftcheck.Check {
+	return thriftcheck.NewCheck("namespace.duplicate", func(c *thriftcheck.C, p *ast.Program, ns *ast.Namespace) {
+		for _, header := range p.Headers {
+			if header == ns {
+				return
+			}
+			if other, ok := header.(*ast.Namespace); ok && other.Scope == ns.Scope {
+				c.Errorf(ns, "%q namespace is already declared as %q", ns.Scope, other.Name)
+				return
+			}
+		}
+	}).WithDescription("Namespaces must be declared at most once per scope")
+}
+
+// CheckNamespaceRequired returns a thriftcheck.Check that reports an error if
+// a file doesn't declare a namespace for each of the given scopes. A namespace
+// for the "*" scope satisfies every scope.
+func CheckNamespaceRequired(scopes []string) thriftcheck.Check {
+	return thriftcheck.NewCheck("namespace.required", func(c *thriftcheck.C, p *ast.Program) {
+		declared := make(map[string]bool)
+		for _, header := range p.Headers {
+			if ns, ok := header.(*ast.Namespace); ok {
+				declared[ns.Scope] = true
+			}
+		}
+		if declared["*"] {
+			return
+		}
+
+		// Report at the file's first statement so the message has a line.
+		var node ast.Node = p
+		if len(p.Headers) > 0 {
+			node = p.Headers[0]
+		} else if len(p.Definitions) > 0 {
+			node = p.Definitions[0]
+		}
+		for _, scope := range scopes {
+			if !declared[scope] {
+				c.Errorf(node, "missing %q namespace", scope)
+			}
+		}
+	}).WithDescription("Files must declare the required namespaces")
 }
@@ -41,3 +41,98 @@ func TestCheckNamespacePattern(t *testing.T) {
 	})
 	RunTests(t, &check, tests)
 }
+
+func TestCheckNamespaceCollision(t *testing.T) {
+	tests := []ProjectTest{
+		{
+			files: map[string]string{
+				"a.thrift": "include \"inc/b.thrift\"\n" +
+					"namespace java com.foo\n" +
+					"struct User {}\n" +
+					"const i32 Account = 1\n" +
+					"enum Status { A }\n",
+				"inc/b.thrift": "namespace java com.foo\n" +
+					"struct User {}\n" +
+					"enum Account { A }\n",
+				"c.thrift": "namespace java com.bar\n" +
+					"struct User {}\n" +
+					"enum Status { A }\n",
+			},
+			lint: []string{"a.thrift", "c.thrift"},
+			want: []string{
+				`a.thrift:3:1: error: type "User" in "java" namespace "com.foo" is also defined in "inc/b.thrift" (namespace.collision)`,
+			},
+		},
+		{
+			files: map[string]string{
+				"a.thrift": "namespace py foo\nstruct User {}\n",
+				"b.thrift": "namespace py foo\ntypedef string User\n",
+			},
+			want: []string{
+				`a.thrift:2:1: error: type "User" in "py" namespace "foo" is also defined in "b.thrift" (namespace.collision)`,
+				`b.thrift:2:1: error: type "User" in "py" namespace "foo" is also defined in "a.thrift" (namespace.collision)`,
+			},
+		},
+	}
+
+	check := checks.CheckNamespaceCollision()
+	RunProjectTests(t, &check, tests)
+}
+
+func TestCheckNamespaceDuplicate(t *testing.T) {
+	java := &ast.Namespace{Scope: "java", Name: "com.foo"}
+	py := &ast.Namespace{Scope: "py", Name: "foo"}
+	dup := &ast.Namespace{Scope: "java", Name: "com.bar"}
+	prog := &ast.Program{Headers: []ast.Header{java, py, dup}}
+
+	tests := []Test{
+		{node: java, ancestors: []ast.Node{prog}, want: []string{}},
+		{node: py, ancestors: []ast.Node{prog}, want: []string{}},
+		{
+			node:      dup,
+			ancestors: []ast.Node{prog},
+			want: []string{
+				`t.thrift:0:1: error: "java" namespace is already declared as "com.foo" (namespace.duplicate)`,
+			},
+		},
+	}
+
+	check := checks.CheckNamespaceDuplicate()
+	RunTests(t, &check, tests)
+}
+
+func TestCheckNamespaceRequired(t *testing.T) {
+	tests := []Test{
+		{
+			node: &ast.Program{Headers: []ast.Header{
+				&ast.Namespace{Scope: "java", Name: "com.foo"},
+				&ast.Namespace{Scope: "py", Name: "foo"},
+			}},
+			want: []string{},
+		},
+		{
+			node: &ast.Program{Headers: []ast.Header{
+				&ast.Namespace{Scope: "*", Name: "foo"},
+			}},
+			want: []string{},
+		},
+		{
+			node: &ast.Program{Headers: []ast.Header{
+				&ast.Namespace{Scope: "java", Name: "com.foo"},
+			}},
+			want: []string{
+				`t.thrift:0:1: error: missing "py" namespace (namespace.required)`,
+			},
+		},
+		{
+			node: &ast.Program{},
+			want: []string{
+				`t.thrift:0:1: error: missing "java" namespace (namespace.required)`,
+				`t.thrift:0:1: error: missing "py" namespace (namespace.required)`,
+			},
+		},
+	}
+
+	check := checks.CheckNamespaceRequired([]string{"java", "py"})
+	RunTests(t, &check, tests)
+}
@@ -0,0 +1,82 @@
+// Copyright 2025 Pinterest
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checks
+
+import (
+	"slices"
+
+	"github.com/pinterest/thriftcheck"
+	"go.uber.org/thriftrw/ast"
+)
+
+// CheckFunctionOneway returns a thriftcheck.Check that reports an error if a
+// `oneway` function returns a value or declares exceptions. Callers never
+// receive a response from a oneway function.
+func CheckFunctionOneway() thriftcheck.Check {
+	return thriftcheck.NewCheck("function.oneway", func(c *thriftcheck.C, f *ast.Function) {
+		if !f.OneWay {
+			return
+		}
+		if f.ReturnType != nil {
+			c.Errorf(f, "oneway function %q must return void", f.Name)
+		}
+		if len(f.Exceptions) > 0 {
+			c.Errorf(f, "oneway function %q must not throw exceptions", f.Name)
+		}
+	}).WithDescription("Oneway functions must return void and not throw exceptions")
+}
+
+// CheckFunctionParamsSize returns a thriftcheck.Check that reports an error if
+// a function has more than limit parameters. A limit of 0 disables the check.
+func CheckFunctionParamsSize(limit int) thriftcheck.Check {
+	return thriftcheck.NewCheck("function.params.size", func(c *thriftcheck.C, f *ast.Function) {
+		if limit > 0 && len(f.Parameters) > limit {
+			c.Errorf(f, "function %q has more than %d parameters", f.Name, limit)
+		}
+	}).WithDescription("Functions must not have too many parameters")
+}
+
+// CheckFunctionThrowsMissing returns a thriftcheck.Check that warns if a
+// function doesn't declare any exceptions. Oneway functions are ignored
+// because they can't throw exceptions.
+func CheckFunctionThrowsMissing() thriftcheck.Check {
+	return thriftcheck.NewCheck("function.throws.missing", func(c *thriftcheck.C, f *ast.Function) {
+		if !f.OneWay && len(f.Exceptions) == 0 {
+			c.Warningf(f, "function %q should declare at least one exception", f.Name)
+		}
+	}).WithDescription("Functions should declare the exceptions they throw")
+}
+
+// CheckFunctionThrowsType returns a thriftcheck.Check that reports an error if
+// a type in a function's `throws` list isn't an `exception`. References that
+// can't be resolved are left to the type.ref check.
+func CheckFunctionThrowsType() thriftcheck.Check {
+	return thriftcheck.NewCheck("function.throws.type", func(c *thriftcheck.C, fn *ast.Function, f *ast.Field) {
+		if !slices.Contains(fn.Exceptions, f) {
+			return
+		}
+
+		ref, ok := f.Type.(ast.TypeReference)
+		if !ok {
+			c.Errorf(f, "exception %q of function %q must be an exception type, not %q", f.Name, fn.Name, f.Type)
+			return
+		}
+		if n := c.ResolveType(ref); n != nil {
+			if s, ok := n.(*ast.Struct); !ok || s.Type != ast.ExceptionType {
+				c.Errorf(f, "exception %q of function %q must be an exception type, not %q", f.Name, fn.Name, ref.Name)
+			}
+		}
+	}).WithDescription("Functions must only throw exception types")
+}
@@ -0,0 +1,113 @@
+// Copyright 2025 Pinterest
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/pinterest/thriftcheck/checks"
+	"go.uber.org/thriftrw/ast"
+)
+
+func TestCheckFunctionOneway(t *testing.T) {
+	tests := []Test{
+		{node: &ast.Function{Name: "f", OneWay: true}, want: []string{}},
+		{node: &ast.Function{Name: "f", ReturnType: i32Type}, want: []string{}},
+		{
+			node: &ast.Function{Name: "f", OneWay: true, ReturnType: i32Type, Exceptions: []*ast.Field{{Name: "e"}}},
+			want: []string{
+				`t.thrift:0:1: error: oneway function "f" must return void (function.oneway)`,
+				`t.thrift:0:1: error: oneway function "f" must not throw exceptions (function.oneway)`,
+			},
+		},
+	}
+
+	check := checks.CheckFunctionOneway()
+	RunTests(t, &check, tests)
+}
+
+func TestCheckFunctionParamsSize(t *testing.T) {
+	tests := []Test{
+		{node: &ast.Function{Name: "f", Parameters: []*ast.Field{{}, {}}}, want: []string{}},
+		{
+			node: &ast.Function{Name: "f", Parameters: []*ast.Field{{}, {}, {}}},
+			want: []string{
+				`t.thrift:0:1: error: function "f" has more than 2 parameters (function.params.size)`,
+			},
+		},
+	}
+
+	check := checks.CheckFunctionParamsSize(2)
+	RunTests(t, &check, tests)
+
+	check = checks.CheckFunctionParamsSize(0)
+	RunTests(t, &check, []Test{
+		{node: &ast.Function{Name: "f", Parameters: []*ast.Field{{}, {}, {}}}, want: []string{}},
+	})
+}
+
+func TestCheckFunctionThrowsMissing(t *testing.T) {
+	tests := []Test{
+		{node: &ast.Function{Name: "f", Exceptions: []*ast.Field{{}}}, want: []string{}},
+		{node: &ast.Function{Name: "f", OneWay: true}, want: []string{}},
+		{
+			node: &ast.Function{Name: "f"},
+			want: []string{
+				`t.thrift:0:1: warning: function "f" should declare at least one exception (function.throws.missing)`,
+			},
+		},
+	}
+
+	check := checks.CheckFunctionThrowsMissing()
+	RunTests(t, &check, tests)
+}
+
+func TestCheckFunctionThrowsType(t *testing.T) {
+	prog := program(
+		&ast.Struct{Name: "Error", Type: ast.ExceptionType},
+		&ast.Struct{Name: "NotError", Type: ast.StructType},
+	)
+	e1 := &ast.Field{Name: "e1", Type: ast.TypeReference{Name: "Error"}}
+	e2 := &ast.Field{Name: "e2", Type: ast.TypeReference{Name: "NotError"}}
+	e3 := &ast.Field{Name: "e3", Type: ast.TypeReference{Name: "Unknown"}}
+	e4 := &ast.Field{Name: "e4", Type: stringType}
+	p := &ast.Field{Name: "p", Type: ast.TypeReference{Name: "NotError"}}
+	fn := &ast.Function{Name: "f", Parameters: []*ast.Field{p}, Exceptions: []*ast.Field{e1, e2, e3, e4}}
+
+	tests := []Test{
+		{prog: prog, node: e1, ancestors: []ast.Node{fn}, want: []string{}},
+		{
+			prog:      prog,
+			node:      e2,
+			ancestors: []ast.Node{fn},
+			want: []string{
+				`t.thrift:0:1: error: exception "e2" of function "f" must be an exception type, not "NotError" (function.throws.type)`,
+			},
+		},
+		{prog: prog, node: e3, ancestors: []ast.Node{fn}, want: []string{}},
+		{
+			prog:      prog,
+			node:      e4,
+			ancestors: []ast.Node{fn},
+			want: []string{
+				`t.thrift:0:1: error: exception "e4" of function "f" must be an exception type, not "string" (function.throws.type)`,
+			},
+		},
+		{prog: prog, node: p, ancestors: []ast.Node{fn}, want: []string{}},
+	}
+
+	check := checks.CheckFunctionThrowsType()
+	RunTests(t, &check, tests)
+}
@@ -26,5 +26,5 @@ func CheckSetValueType(allowedTypes, disallowedTypes []thriftcheck.ThriftType) t
 		if ok, name := c.IsTypeAllowed(st.ValueType, allowedTypes, disallowedTypes); !ok {
 			c.Errorf(st, "set value type %q is not allowed", name)
 		}
-	})
+	}).WithDescription("Set value types must be allowed")
 }
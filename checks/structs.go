@@ -0,0 +1,108 @@
+// Copyright 2025 Pinterest
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checks
+
+import (
+	"github.com/pinterest/thriftcheck"
+	"go.uber.org/thriftrw/ast"
+)
+
+// byStructureType returns the one of the given values that corresponds to a
+// structure type.
+func byStructureType[T any](t ast.StructureType, structValue, unionValue, exceptionValue T) T {
+	switch t {
+	case ast.UnionType:
+		return unionValue
+	case ast.ExceptionType:
+		return exceptionValue
+	default:
+		return structValue
+	}
+}
+
+// structureKind returns the name of a structure type: "struct", "union", or
+// "exception".
+func structureKind(t ast.StructureType) string {
+	return byStructureType(t, "struct", "union", "exception")
+}
+
+// CheckExceptionMessage returns a thriftcheck.Check that warns if an exception
+// doesn't have a `message` string field. The field's type may also be a
+// typedef of a string.
+func CheckExceptionMessage() thriftcheck.Check {
+	return thriftcheck.NewCheck("exception.message", func(c *thriftcheck.C, s *ast.Struct) {
+		if s.Type != ast.ExceptionType {
+			return
+		}
+		for _, f := range s.Fields {
+			if f.Name != "message" {
+				continue
+			}
+			n, _, _ := followType(c, f.Type, c.Filename)
+			if t, ok := n.(ast.BaseType); ok && t.ID == ast.StringTypeID {
+				return
+			}
+		}
+		c.Warningf(s, "exception %q should have a \"message\" string field", s.Name)
+	}).WithDescription("Exceptions should have a message string field")
+}
+
+// CheckStructEmpty returns a thriftcheck.Check that warns if a struct, union,
+// or exception doesn't have any fields.
+func CheckStructEmpty() thriftcheck.Check {
+	return thriftcheck.NewCheck("struct.empty", func(c *thriftcheck.C, s *ast.Struct) {
+		if len(s.Fields) == 0 {
+			c.Warningf(s, "%s %q is empty", structureKind(s.Type), s.Name)
+		}
+	}).WithDescription("Structures should not be empty")
+}
+
+// StructSizes are the maximum number of fields allowed for each kind of
+// structure. A limit of 0 means there is no limit.
+type StructSizes struct {
+	Struct    int
+	Union     int
+	Exception int
+}
+
+// CheckStructSize returns a thriftcheck.Check that reports an error if a
+// struct, union, or exception has more fields than its kind allows.
+func CheckStructSize(limits StructSizes) thriftcheck.Check {
+	return thriftcheck.NewCheck("struct.size", func(c *thriftcheck.C, s *ast.Struct) {
+		if limit := byStructureType(s.Type, limits.Struct, limits.Union, limits.Exception); limit > 0 && len(s.Fields) > limit {
+			c.Errorf(s, "%s %q has more than %d fields", structureKind(s.Type), s.Name, limit)
+		}
+	}).WithDescription("Structures must not have too many fields")
+}
+
+// CheckUnionFieldDefault returns a thriftcheck.Check that reports an error if
+// a union's field has a default value. At most one of a union's fields is set.
+func CheckUnionFieldDefault() thriftcheck.Check {
+	return thriftcheck.NewCheck("union.field.default", func(c *thriftcheck.C, s *ast.Struct, f *ast.Field) {
+		if s.Type == ast.UnionType && f.Default != nil {
+			c.Errorf(f, "field %q (%d) of union %q must not have a default value", f.Name, f.ID, s.Name)
+		}
+	}).WithDescription("Union fields must not have default values")
+}
+
+// CheckUnionFieldRequired returns a thriftcheck.Check that reports an error if
+// a union's field is "required". At most one of a union's fields is set.
+func CheckUnionFieldRequired() thriftcheck.Check {
+	return thriftcheck.NewCheck("union.field.required", func(c *thriftcheck.C, s *ast.Struct, f *ast.Field) {
+		if s.Type == ast.UnionType && f.Requiredness == ast.Required {
+			c.Errorf(f, "field %q (%d) of union %q must not be \"required\"", f.Name, f.ID, s.Name)
+		}
+	}).WithDescription("Union fields must not be required")
+}
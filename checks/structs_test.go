@@ -0,0 +1,161 @@
+// Copyright 2025 Pinterest
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/pinterest/thriftcheck/checks"
+	"go.uber.org/thriftrw/ast"
+)
+
+func TestCheckExceptionMessage(t *testing.T) {
+	prog := program(
+		&ast.Typedef{Name: "Text", Type: stringType},
+		&ast.Typedef{Name: "Code", Type: i32Type},
+	)
+
+	tests := []Test{
+		{node: &ast.Struct{Name: "S", Type: ast.StructType}, want: []string{}},
+		{
+			node: &ast.Struct{Name: "E", Type: ast.ExceptionType, Fields: []*ast.Field{
+				{ID: 1, Name: "message", Type: stringType},
+			}},
+			want: []string{},
+		},
+		{
+			node: &ast.Struct{Name: "E", Type: ast.ExceptionType, Fields: []*ast.Field{
+				{ID: 1, Name: "message", Type: i32Type},
+			}},
+			want: []string{
+				`t.thrift:0:1: warning: exception "E" should have a "message" string field (exception.message)`,
+			},
+		},
+		{
+			prog: prog,
+			node: &ast.Struct{Name: "E", Type: ast.ExceptionType, Fields: []*ast.Field{
+				{ID: 1, Name: "message", Type: ast.TypeReference{Name: "Text"}},
+			}},
+			want: []string{},
+		},
+		{
+			prog: prog,
+			node: &ast.Struct{Name: "E", Type: ast.ExceptionType, Fields: []*ast.Field{
+				{ID: 1, Name: "message", Type: ast.TypeReference{Name: "Code"}},
+			}},
+			want: []string{
+				`t.thrift:0:1: warning: exception "E" should have a "message" string field (exception.message)`,
+			},
+		},
+		{
+			node: &ast.Struct{Name: "E", Type: ast.ExceptionType},
+			want: []string{
+				`t.thrift:0:1: warning: exception "E" should have a "message" string field (exception.message)`,
+			},
+		},
+	}
+
+	check := checks.CheckExceptionMessage()
+	RunTests(t, &check, tests)
+}
+
+func TestCheckStructEmpty(t *testing.T) {
+	tests := []Test{
+		{node: &ast.Struct{Name: "S", Fields: []*ast.Field{{ID: 1, Name: "a"}}}, want: []string{}},
+		{
+			node: &ast.Struct{Name: "S", Type: ast.StructType},
+			want: []string{
+				`t.thrift:0:1: warning: struct "S" is empty (struct.empty)`,
+			},
+		},
+		{
+			node: &ast.Struct{Name: "U", Type: ast.UnionType},
+			want: []string{
+				`t.thrift:0:1: warning: union "U" is empty (struct.empty)`,
+			},
+		},
+	}
+
+	check := checks.CheckStructEmpty()
+	RunTests(t, &check, tests)
+}
+
+func TestCheckStructSize(t *testing.T) {
+	fields := []*ast.Field{{ID: 1}, {ID: 2}, {ID: 3}}
+
+	tests := []Test{
+		{node: &ast.Struct{Name: "S", Type: ast.StructType, Fields: fields}, want: []string{}},
+		{
+			node: &ast.Struct{Name: "U", Type: ast.UnionType, Fields: fields},
+			want: []string{
+				`t.thrift:0:1: error: union "U" has more than 2 fields (struct.size)`,
+			},
+		},
+		{node: &ast.Struct{Name: "E", Type: ast.ExceptionType, Fields: fields}, want: []string{}},
+	}
+
+	check := checks.CheckStructSize(checks.StructSizes{Struct: 3, Union: 2})
+	RunTests(t, &check, tests)
+}
+
+func TestCheckUnionFieldDefault(t *testing.T) {
+	tests := []Test{
+		{
+			node:      &ast.Field{ID: 1, Name: "a", Default: ast.ConstantInteger(1)},
+			ancestors: []ast.Node{&ast.Struct{Name: "S", Type: ast.StructType}},
+			want:      []string{},
+		},
+		{
+			node:      &ast.Field{ID: 1, Name: "a"},
+			ancestors: []ast.Node{&ast.Struct{Name: "U", Type: ast.UnionType}},
+			want:      []string{},
+		},
+		{
+			node:      &ast.Field{ID: 1, Name: "a", Default: ast.ConstantInteger(1)},
+			ancestors: []ast.Node{&ast.Struct{Name: "U", Type: ast.UnionType}},
+			want: []string{
+				`t.thrift:0:1: error: field "a" (1) of union "U" must not have a default value (union.field.default)`,
+			},
+		},
+	}
+
+	check := checks.CheckUnionFieldDefault()
+	RunTests(t, &check, tests)
+}
+
+func TestCheckUnionFieldRequired(t *testing.T) {
+	tests := []Test{
+		{
+			node:      &ast.Field{ID: 1, Name: "a", Requiredness: ast.Required},
+			ancestors: []ast.Node{&ast.Struct{Name: "S", Type: ast.StructType}},
+			want:      []string{},
+		},
+		{
+			node:      &ast.Field{ID: 1, Name: "a", Requiredness: ast.Optional},
+			ancestors: []ast.Node{&ast.Struct{Name: "U", Type: ast.UnionType}},
+			want:      []string{},
+		},
+		{
+			node:      &ast.Field{ID: 1, Name: "a", Requiredness: ast.Required},
+			ancestors: []ast.Node{&ast.Struct{Name: "U", Type: ast.UnionType}},
+			want: []string{
+				`t.thrift:0:1: error: field "a" (1) of union "U" must not be "required" (union.field.required)`,
+			},
+		},
+	}
+
+	check := checks.CheckUnionFieldRequired()
+	RunTests(t, &check, tests)
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 Pinterest
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checks
+
+import (
+	"strings"
+
+	"github.com/pinterest/thriftcheck"
+	"go.uber.org/thriftrw/ast"
+)
+
+// typedefPath formats the resolution path of a typedef, which starts with name
+// and ends with the underlying type or definition.
+func typedefPath(name string, path []string, target ast.Node) string {
+	names := append([]string{name}, path...)
+	if t, ok := target.(ast.Type); ok {
+		names = append(names, typeName(t))
+	}
+	return strings.Join(names, " -> ")
+}
+
+// CheckTypedefAlias returns a thriftcheck.Check that warns if a typedef is
+// only an alias of a named struct, union, or exception, which can be used
+// directly instead.
+func CheckTypedefAlias() thriftcheck.Check {
+	return thriftcheck.NewCheck("typedef.alias", func(c *thriftcheck.C, td *ast.Typedef) {
+		if target, _, path := followType(c, td.Type, c.Filename); len(path) > 0 {
+			if s, ok := target.(*ast.Struct); ok {
+				c.Warningf(td, "typedef %q is an alias of %s %q", td.Name, structureKind(s.Type), path[len(path)-1])
+			}
+		}
+	}).WithDescription("Typedefs should not alias structures")
+}
+
+// CheckTypedefChain returns a thriftcheck.Check that warns if a typedef refers
+// to another typedef. The message includes the full resolution path.
+func CheckTypedefChain() thriftcheck.Check {
+	return thriftcheck.NewCheck("typedef.chain", func(c *thriftcheck.C, td *ast.Typedef) {
+		target, _, path := followType(c, td.Type, c.Filename)
+		if target == nil {
+			return
+		}
+		// The last name in the path is the target itself unless the target is
+		// an underlying type (rather than a definition).
+		typedefs := len(path)
+		if _, ok := target.(ast.Type); !ok {
+			typedefs--
+		}
+		if typedefs > 0 {
+			c.Warningf(td, "typedef %q refers to another typedef: %s", td.Name, typedefPath(td.Name, path, target))
+		}
+	}).WithDescription("Typedefs should not refer to other typedefs")
+}
+
+// CheckTypedefMapKey returns a thriftcheck.Check that reports an error if a
+// `map<>` key type is a typedef of a container type.
+func CheckTypedefMapKey() thriftcheck.Check {
+	return thriftcheck.NewCheck("typedef.map.key", func(c *thriftcheck.C, mt ast.MapType) {
+		target, _, path := followType(c, mt.KeyType, c.Filename)
+		if len(path) == 0 {
+			return
+		}
+		switch target.(type) {
+		case ast.ListType, ast.SetType, ast.MapType:
+			c.Errorf(mt, "map key type %q is a typedef of a container: %s", path[0], typedefPath(path[0], path[1:], target))
+		}
+	}).WithDescription("Map key types must not be typedefs of containers")
+}
@@ -0,0 +1,162 @@
+// Copyright 2025 Pinterest
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/pinterest/thriftcheck/checks"
+	"go.uber.org/thriftrw/ast"
+)
+
+func TestCheckTypedefAlias(t *testing.T) {
+	prog := program(
+		&ast.Struct{Name: "S", Type: ast.StructType},
+		&ast.Typedef{Name: "T", Type: ast.TypeReference{Name: "S"}},
+		&ast.Enum{Name: "E"},
+	)
+
+	tests := []Test{
+		{prog: prog, node: &ast.Typedef{Name: "A", Type: i32Type}, want: []string{}},
+		{prog: prog, node: &ast.Typedef{Name: "A", Type: ast.TypeReference{Name: "E"}}, want: []string{}},
+		{
+			prog: prog,
+			node: &ast.Typedef{Name: "A", Type: ast.TypeReference{Name: "S"}},
+			want: []string{
+				`t.thrift:0:1: warning: typedef "A" is an alias of struct "S" (typedef.alias)`,
+			},
+		},
+		{
+			prog: prog,
+			node: &ast.Typedef{Name: "A", Type: ast.TypeReference{Name: "T"}},
+			want: []string{
+				`t.thrift:0:1: warning: typedef "A" is an alias of struct "S" (typedef.alias)`,
+			},
+		},
+	}
+
+	check := checks.CheckTypedefAlias()
+	RunTests(t, &check, tests)
+}
+
+func TestCheckTypedefChain(t *testing.T) {
+	prog := program(
+		&ast.Struct{Name: "S", Type: ast.StructType},
+		&ast.Typedef{Name: "B", Type: ast.TypeReference{Name: "C"}},
+		&ast.Typedef{Name: "C", Type: i32Type},
+		&ast.Typedef{Name: "T", Type: ast.TypeReference{Name: "S"}},
+	)
+
+	tests := []Test{
+		{prog: prog, node: &ast.Typedef{Name: "A", Type: i32Type}, want: []string{}},
+		{prog: prog, node: &ast.Typedef{Name: "A", Type: ast.TypeReference{Name: "S"}}, want: []string{}},
+		{prog: prog, node: &ast.Typedef{Name: "A", Type: ast.TypeReference{Name: "Unknown"}}, want: []string{}},
+		{
+			prog: prog,
+			node: &ast.Typedef{Name: "A", Type: ast.TypeReference{Name: "B"}},
+			want: []string{
+				`t.thrift:0:1: warning: typedef "A" refers to another typedef: A -> B -> C -> i32 (typedef.chain)`,
+			},
+		},
+		{
+			prog: prog,
+			node: &ast.Typedef{Name: "A", Type: ast.TypeReference{Name: "T"}},
+			want: []string{
+				`t.thrift:0:1: warning: typedef "A" refers to another typedef: A -> T -> S (typedef.chain)`,
+			},
+		},
+	}
+
+	check := checks.CheckTypedefChain()
+	RunTests(t, &check, tests)
+}
+
+func TestCheckTypedefChainIncludes(t *testing.T) {
+	tests := []ProjectTest{
+		{
+			files: map[string]string{
+				"a.thrift": "include \"inc/b.thrift\"\n" +
+					"typedef b.BSmall A\n" +
+					"typedef b.S B\n",
+				"inc/b.thrift": "include \"c.thrift\"\n" +
+					"typedef c.Small BSmall\n" +
+					"struct S {}\n",
+				"inc/c.thrift": "typedef i8 Small\n",
+			},
+			lint: []string{"a.thrift"},
+			want: []string{
+				`a.thrift:2:1: warning: typedef "A" refers to another typedef: A -> b.BSmall -> c.Small -> i8 (typedef.chain)`,
+			},
+		},
+	}
+
+	check := checks.CheckTypedefChain()
+	RunProjectTests(t, &check, tests)
+}
+
+func TestCheckTypedefMapKey(t *testing.T) {
+	prog := program(
+		&ast.Typedef{Name: "ID", Type: stringType},
+		&ast.Typedef{Name: "IDs", Type: ast.ListType{ValueType: ast.TypeReference{Name: "ID"}}},
+		&ast.Typedef{Name: "Keys", Type: ast.TypeReference{Name: "IDs"}},
+		&ast.Typedef{Name: "Small", Type: ast.SetType{ValueType: ast.BaseType{ID: ast.I8TypeID}}},
+	)
+
+	tests := []Test{
+		{prog: prog, node: ast.MapType{KeyType: stringType, ValueType: i32Type}, want: []string{}},
+		{prog: prog, node: ast.MapType{KeyType: ast.TypeReference{Name: "ID"}, ValueType: i32Type}, want: []string{}},
+		{
+			prog: prog,
+			node: ast.MapType{KeyType: ast.TypeReference{Name: "Keys"}, ValueType: i32Type},
+			want: []string{
+				`t.thrift:0:1: error: map key type "Keys" is a typedef of a container: Keys -> IDs -> list<ID> (typedef.map.key)`,
+			},
+		},
+		{
+			prog: prog,
+			node: ast.MapType{KeyType: ast.TypeReference{Name: "Small"}, ValueType: i32Type},
+			want: []string{
+				`t.thrift:0:1: error: map key type "Small" is a typedef of a container: Small -> set<i8> (typedef.map.key)`,
+			},
+		},
+	}
+
+	check := checks.CheckTypedefMapKey()
+	RunTests(t, &check, tests)
+}
+
+func TestCheckTypedefMapKeyIncludes(t *testing.T) {
+	tests := []ProjectTest{
+		{
+			files: map[string]string{
+				"a.thrift": "include \"inc/b.thrift\"\n" +
+					"typedef map<b.L, i32> M\n" +
+					"typedef map<b.ID, i32> N\n",
+				"inc/b.thrift": "include \"c.thrift\"\n" +
+					"typedef c.Lst L\n" +
+					"typedef c.ID ID\n",
+				"inc/c.thrift": "typedef list<i32> Lst\n" +
+					"typedef string ID\n",
+			},
+			lint: []string{"a.thrift"},
+			want: []string{
+				`a.thrift:2:9: error: map key type "b.L" is a typedef of a container: b.L -> c.Lst -> list<i32> (typedef.map.key)`,
+			},
+		},
+	}
+
+	check := checks.CheckTypedefMapKey()
+	RunProjectTests(t, &check, tests)
+}
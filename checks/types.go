@@ -15,15 +15,62 @@
 package checks
 
 import (
+	"errors"
+	"strings"
+
 	"github.com/pinterest/thriftcheck"
 	"go.uber.org/thriftrw/ast"
 )
 
+// CheckTypeRef returns a thriftcheck.Check that ensures that a type
+// reference's target can be resolved to a type definition.
+func CheckTypeRef() thriftcheck.Check {
+	return thriftcheck.NewCheck("type.ref", func(c *thriftcheck.C, ref ast.TypeReference) {
+		n, err := c.Lookup(ref.Name)
+		switch {
+		case errors.Is(err, thriftcheck.ErrIncludeMissing):
+			prefix, _, _ := strings.Cut(ref.Name, ".")
+			c.Errorf(ref, "unable to resolve type %q: %q is not included", ref.Name, prefix)
+		case errors.Is(err, thriftcheck.ErrIncludeNotFound):
+			c.Errorf(ref, "unable to resolve type %q: included file %q not found", ref.Name, includePath(c.Program, ref.Name))
+		case errors.Is(err, thriftcheck.ErrUndefined):
+			if path := includePath(c.Program, ref.Name); path != "" {
+				c.Errorf(ref, "unable to resolve type %q: not defined in %q", ref.Name, path)
+			} else {
+				c.Errorf(ref, "unable to resolve type %q: not defined", ref.Name)
+			}
+		case err != nil:
+			// The included file was found, but it couldn't be parsed.
+			c.Errorf(ref, "unable to resolve type %q: included file %q could not be parsed", ref.Name, includePath(c.Program, ref.Name))
+		default:
+			switch n.(type) {
+			case *ast.Constant, *ast.Service:
+				c.Errorf(ref, "%q is not a type", ref.Name)
+			}
+		}
+	}).WithDescription("Referenced types must be resolvable")
+}
+
+// includePath returns the path of the file included by program that a dotted
+// name refers to, or "" if there isn't one.
+func includePath(program *ast.Program, name string) string {
+	prefix, _, ok := strings.Cut(name, ".")
+	if !ok {
+		return ""
+	}
+	for _, header := range program.Headers {
+		if include, ok := header.(*ast.Include); ok && thriftcheck.IncludeName(include) == prefix {
+			return include.Path
+		}
+	}
+	return ""
+}
+
 // CheckTypesDisallowed reports an error if a disallowed type is used.
 func CheckTypes(allowedTypes, disallowedTypes []thriftcheck.ThriftType) thriftcheck.Check {
 	return thriftcheck.NewCheck("types", func(c *thriftcheck.C, n ast.Node) {
 		if ok, name := c.IsTypeAllowed(n, allowedTypes, disallowedTypes); !ok {
 			c.Errorf(n, "type %q is not allowed", name)
 		}
-	})
+	}).WithDescription("Types must be allowed")
 }
@@ -15,6 +15,8 @@
 package checks_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/pinterest/thriftcheck"
@@ -107,3 +109,108 @@ func TestCheckTypesDisallowed(t *testing.T) {
 	check = checks.CheckTypes([]thriftcheck.ThriftType{}, []thriftcheck.ThriftType{})
 	RunTests(t, &check, tests)
 }
+
+func TestCheckTypeRef(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "shared.thrift"), []byte(`struct Shared {}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "broken.thrift"), []byte(`struct Broken {`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	name := filepath.Join(dir, "t.thrift")
+
+	prog := &ast.Program{
+		Headers: []ast.Header{
+			&ast.Include{Path: "shared.thrift"},
+			&ast.Include{Path: "missing.thrift"},
+			&ast.Include{Path: "broken.thrift"},
+		},
+		Definitions: []ast.Definition{
+			&ast.Struct{Name: "Local"},
+			&ast.Typedef{Name: "Alias", Type: ast.BaseType{ID: ast.I32TypeID}},
+			&ast.Constant{Name: "Constant", Type: ast.BaseType{ID: ast.I32TypeID}},
+			&ast.Service{Name: "Service"},
+		},
+	}
+
+	tests := []Test{
+		{
+			name: name,
+			prog: prog,
+			node: ast.TypeReference{Name: "Local", Line: 1},
+			want: []string{},
+		},
+		{
+			name: name,
+			prog: prog,
+			node: ast.TypeReference{Name: "Alias", Line: 1},
+			want: []string{},
+		},
+		{
+			name: name,
+			prog: prog,
+			node: ast.TypeReference{Name: "shared.Shared", Line: 1},
+			want: []string{},
+		},
+		{
+			name: name,
+			prog: prog,
+			node: ast.TypeReference{Name: "Unknown", Line: 1},
+			want: []string{
+				name + `:1:1: error: unable to resolve type "Unknown": not defined (type.ref)`,
+			},
+		},
+		{
+			name: name,
+			prog: prog,
+			node: ast.TypeReference{Name: "other.Other", Line: 1},
+			want: []string{
+				name + `:1:1: error: unable to resolve type "other.Other": "other" is not included (type.ref)`,
+			},
+		},
+		{
+			name: name,
+			prog: prog,
+			node: ast.TypeReference{Name: "missing.Missing", Line: 1},
+			want: []string{
+				name + `:1:1: error: unable to resolve type "missing.Missing": included file "missing.thrift" not found (type.ref)`,
+			},
+		},
+		{
+			name: name,
+			prog: prog,
+			node: ast.TypeReference{Name: "shared.Missing", Line: 1},
+			want: []string{
+				name + `:1:1: error: unable to resolve type "shared.Missing": not defined in "shared.thrift" (type.ref)`,
+			},
+		},
+		{
+			name: name,
+			prog: prog,
+			node: ast.TypeReference{Name: "broken.Broken", Line: 1},
+			want: []string{
+				name + `:1:1: error: unable to resolve type "broken.Broken": included file "broken.thrift" could not be parsed (type.ref)`,
+			},
+		},
+		{
+			name: name,
+			prog: prog,
+			node: ast.TypeReference{Name: "Constant", Line: 1},
+			want: []string{
+				name + `:1:1: error: "Constant" is not a type (type.ref)`,
+			},
+		},
+		{
+			name: name,
+			prog: prog,
+			node: ast.TypeReference{Name: "Service", Line: 1},
+			want: []string{
+				name + `:1:1: error: "Service" is not a type (type.ref)`,
+			},
+		},
+	}
+
+	check := checks.CheckTypeRef()
+	RunTests(t, &check, tests)
+}
@@ -0,0 +1,158 @@
+// Copyright 2025 Pinterest
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checks
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/danwakefield/fnmatch"
+	"github.com/pinterest/thriftcheck"
+	"go.uber.org/thriftrw/ast"
+)
+
+// CheckUnused returns a thriftcheck.Check that warns about structs, unions,
+// exceptions, enums, typedefs, and constants in the linted files that are
+// never referenced by another definition or service in the linted files or the
+// files they include.
+//
+// Definitions in root files are considered public API and are never reported.
+// Root files are those whose names match one of the files patterns or that
+// declare a namespace that matches the pattern for its scope.
+func CheckUnused(files []string, namespaces map[string]*regexp.Regexp) thriftcheck.Check {
+	isRoot := func(p *thriftcheck.P, filename string, program *ast.Program) bool {
+		name := p.Name(filename)
+		for _, pattern := range files {
+			if fnmatch.Match(pattern, name, fnmatch.FNM_NOESCAPE) {
+				p.Logf("%q is a root file (%s)\n", name, pattern)
+				return true
+			}
+		}
+		for _, header := range program.Headers {
+			if ns, ok := header.(*ast.Namespace); ok {
+				if re, ok := namespaces[ns.Scope]; ok && re.MatchString(ns.Name) {
+					p.Logf("%q is a root file (%s %s)\n", name, ns.Scope, re)
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	return thriftcheck.NewProjectCheck("unused", func(p *thriftcheck.P) {
+		refs := referencedDefinitions(p)
+		for _, filename := range p.Files {
+			program := p.Program(filename)
+			if program == nil || isRoot(p, filename, program) {
+				continue
+			}
+			for _, def := range program.Definitions {
+				var kind string
+				switch d := def.(type) {
+				case *ast.Struct:
+					kind = structureKind(d.Type)
+				case *ast.Enum:
+					kind = "enum"
+				case *ast.Typedef:
+					kind = "typedef"
+				case *ast.Constant:
+					kind = "constant"
+				default:
+					continue
+				}
+				if name := def.Info().Name; !refs[definitionRef{filename, name}] {
+					p.Warningf(filename, def, "%s %q is never used", kind, name)
+				}
+			}
+		}
+	}).WithDescription("Definitions should be referenced")
+}
+
+// definitionRef identifies a definition by the absolute path of the file that
+// defines it and its name.
+type definitionRef struct {
+	filename string
+	name     string
+}
+
+// referencedDefinitions returns the set of definitions that are referenced by
+// the types, constants, and services in all of the files in a project's include
+// graph. A definition's references to itself don't count. When a reference is
+// ambiguous, all of its possible targets are considered referenced.
+func referencedDefinitions(p *thriftcheck.P) map[definitionRef]bool {
+	refs := make(map[definitionRef]bool)
+
+	for _, filename := range p.Graph.Files() {
+		program := p.Program(filename)
+		if program == nil {
+			continue
+		}
+
+		includes := make(map[string]string)
+		for _, header := range program.Headers {
+			if include, ok := header.(*ast.Include); ok {
+				if path, ok := p.Locate(filename, include); ok {
+					includes[thriftcheck.IncludeName(include)] = path
+				}
+			}
+		}
+
+		// add records a reference from the owner definition to a definition
+		// ("Name"), which may be qualified by an include prefix ("inc.Name").
+		// A constant reference may also be followed by an enum item
+		// ("Enum.ITEM").
+		add := func(owner, name string, constant bool) {
+			if prefix, rest, ok := strings.Cut(name, "."); ok {
+				if path, ok := includes[prefix]; ok {
+					if constant {
+						rest, _, _ = strings.Cut(rest, ".")
+					}
+					refs[definitionRef{path, rest}] = true
+				}
+				if !constant {
+					return
+				}
+				name = prefix
+			}
+			if name != owner {
+				refs[definitionRef{filename, name}] = true
+			}
+		}
+
+		ast.Walk(ast.VisitorFunc(func(w ast.Walker, n ast.Node) {
+			// The owner is the top-level definition that contains the node.
+			// The last ancestor is the program itself.
+			var owner string
+			if ancestors := w.Ancestors(); len(ancestors) > 1 {
+				if def, ok := ancestors[len(ancestors)-2].(ast.Definition); ok {
+					owner = def.Info().Name
+				}
+			}
+
+			switch n := n.(type) {
+			case ast.TypeReference:
+				add(owner, n.Name, false)
+			case ast.ConstantReference:
+				add(owner, n.Name, true)
+			case *ast.Service:
+				if n.Parent != nil {
+					add(n.Name, n.Parent.Name, false)
+				}
+			}
+		}), program)
+	}
+
+	return refs
+}
@@ -0,0 +1,91 @@
+// Copyright 2025 Pinterest
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checks_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/pinterest/thriftcheck/checks"
+)
+
+func TestCheckUnused(t *testing.T) {
+	tests := []ProjectTest{
+		{
+			files: map[string]string{
+				"a.thrift": "include \"shared/b.thrift\"\n" +
+					"struct Node { 1: optional Node child }\n" +
+					"struct Used { 1: optional Status status = Status.ACTIVE }\n" +
+					"enum Status { UNKNOWN, ACTIVE }\n" +
+					"exception Error {}\n" +
+					"union U {}\n" +
+					"const i32 LIMIT = 10\n" +
+					"const i32 MAX = LIMIT\n" +
+					"typedef string ID\n" +
+					"service S { Used get(1: b.Request r) throws (1: Error e) }\n",
+				"shared/b.thrift": "struct Request {}\n" +
+					"struct Response {}\n",
+			},
+			lint: []string{"a.thrift", "shared/b.thrift"},
+			want: []string{
+				`a.thrift:2:1: warning: struct "Node" is never used (unused)`,
+				`a.thrift:6:1: warning: union "U" is never used (unused)`,
+				`a.thrift:8:1: warning: constant "MAX" is never used (unused)`,
+				`a.thrift:9:1: warning: typedef "ID" is never used (unused)`,
+				`shared/b.thrift:2:1: warning: struct "Response" is never used (unused)`,
+			},
+		},
+		{
+			files: map[string]string{
+				"a.thrift": "include \"b.thrift\"\n" +
+					"const i32 X = b.LIMIT\n",
+				"b.thrift": "const i32 LIMIT = 10\n" +
+					"const i32 OTHER = 10\n",
+			},
+			lint: []string{"b.thrift"},
+			want: []string{
+				`b.thrift:1:1: warning: constant "LIMIT" is never used (unused)`,
+				`b.thrift:2:1: warning: constant "OTHER" is never used (unused)`,
+			},
+		},
+	}
+
+	check := checks.CheckUnused(nil, nil)
+	RunProjectTests(t, &check, tests)
+}
+
+func TestCheckUnusedRoots(t *testing.T) {
+	files := map[string]string{
+		"api/a.thrift":    "struct Request {}\n",
+		"b.thrift":        "namespace java com.example.api\nstruct Response {}\n",
+		"c.thrift":        "namespace java com.example.internal\nstruct Internal {}\n",
+		"internal.thrift": "struct Private {}\n",
+	}
+
+	tests := []ProjectTest{
+		{
+			files: files,
+			want: []string{
+				`c.thrift:2:1: warning: struct "Internal" is never used (unused)`,
+				`internal.thrift:1:1: warning: struct "Private" is never used (unused)`,
+			},
+		},
+	}
+
+	check := checks.CheckUnused(
+		[]string{"api/*.thrift"},
+		map[string]*regexp.Regexp{"java": regexp.MustCompile(`^com\.example\.api`)})
+	RunProjectTests(t, &check, tests)
+}
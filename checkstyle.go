@@ -0,0 +1,81 @@
+// Copyright 2025 Pinterest
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thriftcheck
+
+import (
+	"encoding/xml"
+	"io"
+)
+
+// CheckstyleReporter is a Reporter that writes Messages using the Checkstyle
+// XML format. Messages are grouped by file in the order the files were first
+// reported.
+type CheckstyleReporter struct{}
+
+type checkstyleOutput struct {
+	XMLName xml.Name         `xml:"checkstyle"`
+	Version string           `xml:"version,attr"`
+	Files   []checkstyleFile `xml:"file"`
+}
+
+type checkstyleFile struct {
+	Name   string            `xml:"name,attr"`
+	Errors []checkstyleError `xml:"error"`
+}
+
+type checkstyleError struct {
+	Line     int    `xml:"line,attr"`
+	Column   int    `xml:"column,attr"`
+	Severity string `xml:"severity,attr"`
+	Message  string `xml:"message,attr"`
+	Source   string `xml:"source,attr"`
+}
+
+// Report writes msgs to w.
+func (CheckstyleReporter) Report(w io.Writer, msgs Messages) error {
+	out := checkstyleOutput{Version: "4.3"}
+
+	files := make(map[string]int)
+	for _, m := range msgs {
+		i, ok := files[m.Filename]
+		if !ok {
+			i = len(out.Files)
+			files[m.Filename] = i
+			out.Files = append(out.Files, checkstyleFile{Name: m.Filename})
+		}
+		out.Files[i].Errors = append(out.Files[i].Errors, checkstyleError{
+			Line:     m.Pos.Line,
+			Column:   m.column(),
+			Severity: m.Severity.String(),
+			Message:  m.Message,
+			Source:   m.Check,
+		})
+	}
+
+	return writeXML(w, out)
+}
+
+func writeXML(w io.Writer, v any) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
@@ -0,0 +1,58 @@
+// Copyright 2025 Pinterest
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thriftcheck
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCheckstyleReporter(t *testing.T) {
+	tests := []struct {
+		msgs Messages
+		want string
+	}{
+		{
+			msgs: nil,
+			want: `<?xml version="1.0" encoding="UTF-8"?>
+<checkstyle version="4.3"></checkstyle>
+`,
+		},
+		{
+			msgs: testMessages,
+			want: `<?xml version="1.0" encoding="UTF-8"?>
+<checkstyle version="4.3">
+  <file name="a.thrift">
+    <error line="5" column="1" severity="warning" message="Warning" source="field.check"></error>
+  </file>
+  <file name="b.thrift">
+    <error line="2" column="3" severity="error" message="Error" source="type.check"></error>
+    <error line="1" column="1" severity="error" message="syntax error" source="parse"></error>
+  </file>
+</checkstyle>
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		if err := (CheckstyleReporter{}).Report(&buf, tt.msgs); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := buf.String(); got != tt.want {
+			t.Errorf("expected:\n%s\ngot:\n%s", tt.want, got)
+		}
+	}
+}
@@ -18,17 +18,31 @@ Thriftcheck lints Thrift IDL files.
 Usage:
 
 	thriftcheck [options] [path ...]
+	thriftcheck lsp [options]
+
+The lsp command runs a Language Server Protocol server over standard input
+and output, which publishes diagnostics for open documents.
 
 Options:
 
 	-I, --include value
 		include path (can be specified multiple times)
+	--baseline file
+		only report messages that aren't recorded in the baseline file
 	-c, --config string
 		configuration file path (default ".thriftcheck.toml")
+	--compat dir
+		check compatibility with a previous revision of the files rooted at dir
 	--errors-only
 		only report errors (not warnings)
+	--fix
+		automatically fix problems where possible
+	--format string
+		output format: text, json, sarif, checkstyle, or junit (default "text")
 	-h, --help
 		show command help
+	-j, --jobs int
+		number of files to lint in parallel (default: number of CPUs)
 	-l, --list
 		list all available checks with their status and exit
 	--stdin-filename string
@@ -37,22 +51,28 @@ Options:
 		enable verbose (debugging) output
 	--version
 		print the version and exit
+	--watch
+		keep running and re-lint files as they change
+	--write-baseline
+		record all current messages in the baseline file and exit
 */
 package main
 
 import (
-	"errors"
 	"flag"
 	"fmt"
 	"log"
 	"os"
 	"path/filepath"
 	"regexp"
+	"runtime"
+	"slices"
 	"strings"
 
 	"github.com/kkyr/fig"
 	"github.com/pinterest/thriftcheck"
 	"github.com/pinterest/thriftcheck/checks"
+	"github.com/pinterest/thriftcheck/lsp"
 	"rsc.io/getopt"
 )
 
@@ -62,14 +82,36 @@ type Config struct {
 	Checks   struct {
 		Enabled  []string `fig:"enabled"`
 		Disabled []string `fix:"disabled"`
+		OptIn    []string `fig:"optIn"`
 
 		Enum struct {
+			Sentinel struct {
+				Names []string `fig:"names"`
+			}
 			Size struct {
 				Warning int `fig:"warning"`
 				Error   int `fig:"error"`
 			}
 		}
 
+		Field struct {
+			ID struct {
+				Range struct {
+					Min     int                            `fig:"min" default:"1"`
+					Max     int                            `fig:"max" default:"32767"`
+					Structs map[string]checks.FieldIDRange `fig:"structs"`
+				}
+			}
+		}
+
+		Function struct {
+			Params struct {
+				Size struct {
+					Max int `fig:"max"`
+				}
+			}
+		}
+
 		Include struct {
 			Restricted map[string]*regexp.Regexp `fig:"restricted"`
 		}
@@ -91,17 +133,29 @@ type Config struct {
 		}
 
 		Names struct {
-			Reserved []string `fig:"reserved"`
+			Reserved  []string          `fig:"reserved"`
+			Languages []checks.Language `fig:"languages"`
+			Style     checks.NameStyles `fig:"style"`
 		}
 
 		Namespace struct {
 			Patterns map[string]*regexp.Regexp `fig:"patterns"`
+			Required []string                  `fig:"required"`
+		}
+
+		Struct struct {
+			Size checks.StructSizes `fig:"size"`
 		}
 
 		Types struct {
 			AllowedTypes    []thriftcheck.ThriftType `fig:"allowedTypes"`
 			DisallowedTypes []thriftcheck.ThriftType `fig:"disallowedTypes"`
 		}
+
+		Unused struct {
+			Files      []string                  `fig:"files"`
+			Namespaces map[string]*regexp.Regexp `fig:"namespaces"`
+		}
 	}
 }
 
@@ -122,25 +176,45 @@ var (
 	version       = "dev"
 	revision      = "dev"
 	includes      Strings
+	baselineFile  = flag.String("baseline", "", "only report messages that aren't recorded in the baseline `file`")
 	configFile    = flag.String("c", ".thriftcheck.toml", "configuration file path")
+	compatDir     = flag.String("compat", "", "check compatibility with a previous revision of the files rooted at `dir`")
 	errorsOnly    = flag.Bool("errors-only", false, "only report errors (not warnings)")
+	fixFlag       = flag.Bool("fix", false, "automatically fix problems where possible")
+	format        = flag.String("format", "text", "output format: text, json, sarif, checkstyle, or junit")
 	helpFlag      = flag.Bool("h", false, "show command help")
+	jobs          = flag.Int("j", 0, "number of files to lint in parallel (default: number of CPUs)")
 	listFlag      = flag.Bool("l", false, "list all available checks with their status and exit")
 	stdinFilename = flag.String("stdin-filename", "stdin", "filename used when piping from stdin")
 	verboseFlag   = flag.Bool("v", false, "enable verbose (debugging) output")
 	versionFlag   = flag.Bool("version", false, "print the version and exit")
+	watchFlag     = flag.Bool("watch", false, "keep running and re-lint files as they change")
+	writeBaseline = flag.Bool("write-baseline", false, "record all current messages in the baseline file and exit")
 )
 
+// optInChecks are only run when they're matched by the configuration's "optIn"
+// or "enabled" lists, because they would report on most existing IDL or need
+// the whole tree to be linted at once.
+var optInChecks = []string{
+	"enum.prefix",
+	"enum.sentinel",
+	"enum.value.missing",
+	"function.throws.missing",
+	"unused",
+}
+
 func init() {
 	flag.Var(&includes, "I", "include path (can be specified multiple times)")
 	flag.Usage = func() {
 		fmt.Fprintf(flag.CommandLine.Output(), "usage: thriftcheck [options] [path ...]\n")
+		fmt.Fprintf(flag.CommandLine.Output(), "       thriftcheck lsp [options]\n")
 		getopt.PrintDefaults()
 	}
 	getopt.Aliases(
 		"I", "include",
 		"c", "config",
 		"h", "help",
+		"j", "jobs",
 		"l", "list",
 		"v", "verbose")
 }
@@ -156,14 +230,77 @@ func isFlagSet(name string) bool {
 }
 
 func loadConfig(cfg *Config) error {
-	if err := fig.Load(cfg, fig.UseStrict(), fig.File(*configFile)); err != nil {
-		// Ignore FileNotFound when we're using the default configuration file.
-		if errors.Is(err, fig.ErrFileNotFound) && !isFlagSet("c") {
-			return nil
-		}
+	options := []fig.Option{fig.UseStrict(), fig.File(*configFile)}
+
+	// Allow a missing file when we're using the default configuration file.
+	// The defaults are still applied in that case.
+	if !isFlagSet("c") {
+		options = append(options, fig.AllowNoFile())
+	}
+
+	return fig.Load(cfg, options...)
+}
+
+func readBaseline(filename string) (*thriftcheck.Baseline, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return thriftcheck.ReadBaseline(f)
+}
+
+func writeBaselineFile(filename string, msgs thriftcheck.Messages) error {
+	f, err := os.Create(filename)
+	if err != nil {
 		return err
 	}
-	return nil
+	if err := thriftcheck.NewBaseline(msgs).Write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// fix applies the fixes attached to msgs to their files in place and returns
+// the messages that remain unfixed.
+func fix(msgs thriftcheck.Messages) (thriftcheck.Messages, error) {
+	var filenames []string
+	byFile := make(map[string]thriftcheck.Messages)
+	for _, m := range msgs {
+		if _, ok := byFile[m.Filename]; !ok {
+			filenames = append(filenames, m.Filename)
+		}
+		byFile[m.Filename] = append(byFile[m.Filename], m)
+	}
+
+	remaining := thriftcheck.Messages{}
+	for _, filename := range filenames {
+		msgs := byFile[filename]
+		if !slices.ContainsFunc(msgs, func(m thriftcheck.Message) bool { return len(m.Fix) > 0 }) {
+			remaining = append(remaining, msgs...)
+			continue
+		}
+
+		info, err := os.Stat(filename)
+		if err != nil {
+			return nil, err
+		}
+		src, err := os.ReadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+
+		out, unfixed := thriftcheck.ApplyFixes(src, msgs)
+		if len(unfixed) < len(msgs) {
+			if err := os.WriteFile(filename, out, info.Mode().Perm()); err != nil {
+				return nil, err
+			}
+		}
+		remaining = append(remaining, unfixed...)
+	}
+
+	return remaining, nil
 }
 
 func lint(l *thriftcheck.Linter, paths []string) (thriftcheck.Messages, error) {
@@ -177,6 +314,41 @@ func lint(l *thriftcheck.Linter, paths []string) (thriftcheck.Messages, error) {
 	return l.LintFiles(paths)
 }
 
+// commonDir returns the deepest directory that contains all of the given
+// paths, which are files or directories. Standard input ("-") is ignored.
+func commonDir(paths []string) string {
+	var common []string
+	for _, path := range paths {
+		if path == "-" {
+			continue
+		}
+		dir, err := filepath.Abs(path)
+		if err != nil {
+			continue
+		}
+		if info, err := os.Stat(dir); err == nil && !info.IsDir() {
+			dir = filepath.Dir(dir)
+		}
+		parts := strings.Split(dir, string(filepath.Separator))
+		if common == nil {
+			common = parts
+			continue
+		}
+		n := 0
+		for n < len(common) && n < len(parts) && common[n] == parts[n] {
+			n++
+		}
+		common = common[:n]
+	}
+	if len(common) == 0 {
+		return ""
+	}
+	if dir := strings.Join(common, string(filepath.Separator)); dir != "" {
+		return dir
+	}
+	return string(filepath.Separator)
+}
+
 func expandPaths(paths []string) ([]string, error) {
 	var filenames []string
 	for _, path := range paths {
@@ -209,9 +381,58 @@ func expandPaths(paths []string) ([]string, error) {
 	return filenames, nil
 }
 
+// report filters, fixes, and reports messages, and returns the resulting exit
+// status.
+func report(reporter thriftcheck.Reporter, baseline *thriftcheck.Baseline, messages thriftcheck.Messages) (int, error) {
+	if baseline != nil {
+		messages = baseline.Filter(messages)
+	}
+
+	// Report any messages produced by the linter, fixing them if requested
+	reported := thriftcheck.Messages{}
+	for _, m := range messages {
+		if *errorsOnly && m.Severity != thriftcheck.Error {
+			continue
+		}
+		reported = append(reported, m)
+	}
+	if *fixFlag {
+		var err error
+		if reported, err = fix(reported); err != nil {
+			return 0, err
+		}
+	}
+	status := 0
+	for _, m := range reported {
+		status |= 1 << uint(m.Severity)
+	}
+	return status, reporter.Report(os.Stdout, reported)
+}
+
+func newReporter(format string, checks thriftcheck.Checks) (thriftcheck.Reporter, error) {
+	switch format {
+	case "text":
+		return thriftcheck.TextReporter{}, nil
+	case "json":
+		return thriftcheck.JSONReporter{}, nil
+	case "sarif":
+		return thriftcheck.SARIFReporter{Checks: checks, Version: version}, nil
+	case "checkstyle":
+		return thriftcheck.CheckstyleReporter{}, nil
+	case "junit":
+		return thriftcheck.JUnitReporter{Checks: checks}, nil
+	}
+	return nil, fmt.Errorf("unknown output format %q", format)
+}
+
 func main() {
 	// Parse command line flags
-	if err := getopt.CommandLine.Parse(os.Args[1:]); err != nil {
+	args := os.Args[1:]
+	lspMode := len(args) > 0 && args[0] == "lsp"
+	if lspMode {
+		args = args[1:]
+	}
+	if err := getopt.CommandLine.Parse(args); err != nil {
 		os.Exit(1 << uint(thriftcheck.Error))
 	}
 	if *helpFlag {
@@ -222,6 +443,10 @@ func main() {
 		fmt.Fprintf(flag.CommandLine.Output(), "thriftcheck %s (%s)\n", version, revision)
 		os.Exit(0)
 	}
+	if *writeBaseline && *baselineFile == "" {
+		fmt.Fprintln(flag.CommandLine.Output(), "--write-baseline requires --baseline")
+		os.Exit(1 << uint(thriftcheck.Error))
+	}
 
 	// Load the (optional) configuration file
 	var cfg Config
@@ -236,26 +461,72 @@ func main() {
 
 	// Build the set of checks we'll use for the linter
 	allChecks := thriftcheck.Checks{
+		checks.CheckCompatEnumRemoved(),
+		checks.CheckCompatFieldID(),
+		checks.CheckCompatFieldRemoved(),
+		checks.CheckCompatFieldRequiredness(),
+		checks.CheckCompatFieldType(),
+		checks.CheckCompatFunctionRemoved(),
+		checks.CheckCompatFunctionSignature(),
 		checks.CheckConstantRef(),
+		checks.CheckConstantType(),
+		checks.CheckEnumPrefix(),
+		checks.CheckEnumSentinel(cfg.Checks.Enum.Sentinel.Names),
 		checks.CheckEnumSize(cfg.Checks.Enum.Size.Warning, cfg.Checks.Enum.Size.Error),
+		checks.CheckEnumValueDuplicate(),
+		checks.CheckEnumValueMissing(),
+		checks.CheckEnumValueNegative(),
+		checks.CheckExceptionMessage(),
+		checks.CheckFieldIDDuplicate(),
 		checks.CheckFieldIDMissing(),
 		checks.CheckFieldIDNegative(),
+		checks.CheckFieldIDRange(
+			checks.FieldIDRange{Min: cfg.Checks.Field.ID.Range.Min, Max: cfg.Checks.Field.ID.Range.Max},
+			cfg.Checks.Field.ID.Range.Structs),
 		checks.CheckFieldIDZero(),
 		checks.CheckFieldOptional(),
 		checks.CheckFieldRequiredness(),
+		checks.CheckFieldReserved(),
+		checks.CheckFieldDefaultType(),
 		checks.CheckFieldDocMissing(),
+		checks.CheckFunctionOneway(),
+		checks.CheckFunctionParamsSize(cfg.Checks.Function.Params.Size.Max),
+		checks.CheckFunctionThrowsMissing(),
+		checks.CheckFunctionThrowsType(),
+		checks.CheckIncludeCycle(),
 		checks.CheckIncludePath(),
 		checks.CheckIncludeRestricted(cfg.Checks.Include.Restricted),
+		checks.CheckIncludeUnused(),
 		checks.CheckInteger64bit(),
+		checks.CheckIntegerRange(),
 		checks.CheckMapKeyType(cfg.Checks.Map.Key.AllowedTypes, cfg.Checks.Map.Key.DisallowedTypes),
 		checks.CheckMapValueType(cfg.Checks.Map.Value.AllowedTypes, cfg.Checks.Map.Value.DisallowedTypes),
-		checks.CheckNamesReserved(cfg.Checks.Names.Reserved),
+		checks.CheckNamesReserved(cfg.Checks.Names.Reserved, cfg.Checks.Names.Languages),
+		checks.CheckNamesStyle(cfg.Checks.Names.Style),
+		checks.CheckNamespaceCollision(),
+		checks.CheckNamespaceDuplicate(),
 		checks.CheckNamespacePattern(cfg.Checks.Namespace.Patterns),
+		checks.CheckNamespaceRequired(cfg.Checks.Namespace.Required),
 		checks.CheckSetValueType(cfg.Checks.Set.AllowedTypes, cfg.Checks.Set.DisallowedTypes),
+		checks.CheckStructEmpty(),
+		checks.CheckStructSize(cfg.Checks.Struct.Size),
+		checks.CheckTypeRef(),
+		checks.CheckTypedefAlias(),
+		checks.CheckTypedefChain(),
+		checks.CheckTypedefMapKey(),
 		checks.CheckTypes(cfg.Checks.Types.AllowedTypes, cfg.Checks.Types.DisallowedTypes),
+		checks.CheckUnionFieldDefault(),
+		checks.CheckUnionFieldRequired(),
+		checks.CheckUnused(cfg.Checks.Unused.Files, cfg.Checks.Unused.Namespaces),
+	}
+
+	// Opt-in checks that weren't asked for are disabled
+	var skipped []string
+	for _, check := range allChecks.With(optInChecks).Without(cfg.Checks.OptIn).Without(cfg.Checks.Enabled) {
+		skipped = append(skipped, check.Name)
 	}
 
-	checks := allChecks
+	checks := allChecks.Without(skipped)
 	if len(cfg.Checks.Disabled) > 0 {
 		checks = checks.Without(cfg.Checks.Disabled)
 	}
@@ -277,20 +548,60 @@ func main() {
 		os.Exit(0)
 	}
 
+	reporter, err := newReporter(*format, checks)
+	if err != nil {
+		fmt.Fprintln(flag.CommandLine.Output(), err)
+		os.Exit(1 << uint(thriftcheck.Error))
+	}
+
 	// Build the set of linter options
+	if *jobs < 1 {
+		*jobs = runtime.NumCPU()
+	}
 	options := []thriftcheck.Option{
 		thriftcheck.WithIncludes(cfg.Includes),
+		thriftcheck.WithConcurrency(*jobs),
+	}
+	if *compatDir != "" {
+		if info, err := os.Stat(*compatDir); err != nil || !info.IsDir() {
+			fmt.Fprintf(flag.CommandLine.Output(), "--compat: %q is not a directory\n", *compatDir)
+			os.Exit(1 << uint(thriftcheck.Error))
+		}
+		options = append(options, thriftcheck.WithPrevious(*compatDir, commonDir(flag.Args())))
 	}
+	var logger *log.Logger
 	if *verboseFlag {
-		logger := log.New(os.Stderr, "", log.Ltime|log.Lmicroseconds|log.Lshortfile)
+		logger = log.New(os.Stderr, "", log.Ltime|log.Lmicroseconds|log.Lshortfile)
 		options = append(options, thriftcheck.WithLogger(logger))
 	}
 
+	// Run the language server, which lints documents as they're edited
+	if lspMode {
+		server := lsp.NewServer(thriftcheck.NewLinter(checks, options...), version, logger)
+		if err := server.Serve(os.Stdin, os.Stdout); err != nil {
+			fmt.Fprintln(flag.CommandLine.Output(), err)
+			os.Exit(1 << uint(thriftcheck.Error))
+		}
+		os.Exit(0)
+	}
+
 	paths := flag.Args()
 	if len(paths) == 0 {
 		flag.Usage()
 		os.Exit(0)
 	}
+	if *fixFlag && len(paths) == 1 && paths[0] == "-" {
+		fmt.Fprintln(flag.CommandLine.Output(), "--fix can't be used with standard input")
+		os.Exit(1 << uint(thriftcheck.Error))
+	}
+	if *watchFlag && len(paths) == 1 && paths[0] == "-" {
+		fmt.Fprintln(flag.CommandLine.Output(), "--watch can't be used with standard input")
+		os.Exit(1 << uint(thriftcheck.Error))
+	}
+	if *watchFlag && *fixFlag {
+		fmt.Fprintln(flag.CommandLine.Output(), "--fix can't be used with --watch")
+		os.Exit(1 << uint(thriftcheck.Error))
+	}
 
 	// Create the linter and run it over the input files
 	linter := thriftcheck.NewLinter(checks, options...)
@@ -300,14 +611,43 @@ func main() {
 		os.Exit(1 << uint(thriftcheck.Error))
 	}
 
-	// Print any messages reported by the linter
-	status := 0
-	for _, m := range messages {
-		if *errorsOnly && m.Severity != thriftcheck.Error {
-			continue
+	// Record or filter existing messages using the (optional) baseline file
+	var baseline *thriftcheck.Baseline
+	if *baselineFile != "" {
+		if *writeBaseline {
+			if err := writeBaselineFile(*baselineFile, messages); err != nil {
+				fmt.Fprintln(flag.CommandLine.Output(), err)
+				os.Exit(1 << uint(thriftcheck.Error))
+			}
+			os.Exit(0)
 		}
-		fmt.Println(m)
-		status |= 1 << uint(m.Severity)
+
+		if baseline, err = readBaseline(*baselineFile); err != nil {
+			fmt.Fprintln(flag.CommandLine.Output(), err)
+			os.Exit(1 << uint(thriftcheck.Error))
+		}
+	}
+
+	status, err := report(reporter, baseline, messages)
+	if err != nil {
+		fmt.Fprintln(flag.CommandLine.Output(), err)
+		os.Exit(1 << uint(thriftcheck.Error))
 	}
+
+	// Keep re-linting the files affected by each change until interrupted
+	if *watchFlag {
+		if err := watch(paths, linter.Parser(), func(filenames []string) error {
+			messages, err := linter.LintFiles(filenames)
+			if err != nil {
+				return err
+			}
+			_, err = report(reporter, baseline, messages)
+			return err
+		}); err != nil {
+			fmt.Fprintln(flag.CommandLine.Output(), err)
+		}
+		os.Exit(1 << uint(thriftcheck.Error))
+	}
+
 	os.Exit(status)
 }
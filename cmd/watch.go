@@ -0,0 +1,139 @@
+// Copyright 2025 Pinterest
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/pinterest/thriftcheck"
+)
+
+// watchInterval is how often watched files are checked for changes.
+const watchInterval = 500 * time.Millisecond
+
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// watcher tracks the files linted from a set of paths along with all of the
+// files they transitively include.
+type watcher struct {
+	paths     []string
+	parser    *thriftcheck.FileParser
+	filenames map[string]string // absolute path -> filename
+	graph     *thriftcheck.IncludeGraph
+	states    map[string]fileState
+}
+
+func newWatcher(paths []string, parser *thriftcheck.FileParser) (*watcher, error) {
+	w := &watcher{paths: paths, parser: parser}
+	if _, err := w.scan(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// scan expands the watched paths and rebuilds the include graph. It returns
+// the absolute paths of the files that were added, removed, or modified since
+// the previous scan.
+func (w *watcher) scan() ([]string, error) {
+	filenames, err := expandPaths(w.paths)
+	if err != nil {
+		return nil, err
+	}
+
+	w.filenames = make(map[string]string, len(filenames))
+	for _, filename := range filenames {
+		if path, err := filepath.Abs(filename); err == nil {
+			w.filenames[path] = filename
+		}
+	}
+
+	w.graph = thriftcheck.NewIncludeGraph(w.parser, filenames)
+	states := make(map[string]fileState)
+	for _, path := range w.graph.Files() {
+		if info, err := os.Stat(path); err == nil {
+			states[path] = fileState{modTime: info.ModTime(), size: info.Size()}
+		}
+	}
+
+	var changed []string
+	for path, state := range states {
+		if prev, ok := w.states[path]; !ok || prev != state {
+			changed = append(changed, path)
+		}
+	}
+	for path := range w.states {
+		if _, ok := states[path]; !ok {
+			changed = append(changed, path)
+		}
+	}
+	w.states = states
+
+	return changed, nil
+}
+
+// affected returns the linted files that are affected by changes to the given
+// files: the files themselves, and any files that (transitively) include them.
+func (w *watcher) affected(prev *thriftcheck.IncludeGraph, changed []string) []string {
+	// A removed file is no longer part of the current graph, so we also
+	// consult the previous graph to find the files that used to include it.
+	paths := append(prev.Dependents(changed...), w.graph.Dependents(changed...)...)
+	slices.Sort(paths)
+
+	var filenames []string
+	for _, path := range slices.Compact(paths) {
+		if filename, ok := w.filenames[path]; ok {
+			filenames = append(filenames, filename)
+		}
+	}
+	return filenames
+}
+
+// watch polls for file changes until the process is interrupted, calling lint
+// with the affected files each time a change is detected. It only returns if
+// the paths can't be watched in the first place.
+func watch(paths []string, parser *thriftcheck.FileParser, lint func(filenames []string) error) error {
+	w, err := newWatcher(paths, parser)
+	if err != nil {
+		return err
+	}
+
+	tick := time.Tick(watchInterval)
+	for {
+		<-tick
+		prev := w.graph
+		changed, err := w.scan()
+		if err != nil {
+			// The watched paths may be changing underneath us, so report
+			// the error and try again on the next tick.
+			fmt.Fprintln(flag.CommandLine.Output(), err)
+			continue
+		}
+		if filenames := w.affected(prev, changed); len(filenames) > 0 {
+			// Likewise, a file may be unreadable or only partially written
+			// when we lint it, so keep watching for the next change.
+			if err := lint(filenames); err != nil {
+				fmt.Fprintln(flag.CommandLine.Output(), err)
+			}
+		}
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2025 Pinterest
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thriftcheck
+
+import (
+	"bytes"
+	"slices"
+
+	"go.uber.org/thriftrw/ast"
+)
+
+// ApplyFixes applies the fixes attached to msgs to src, the content of the
+// file the messages refer to. A fix is skipped if any of its edits overlap an
+// edit that belongs to a fix that has already been applied, or if any of its
+// edits refer to a position outside of src.
+//
+// It returns the updated content and the list of messages that remain
+// unfixed, which includes those without fixes.
+func ApplyFixes(src []byte, msgs Messages) ([]byte, Messages) {
+	lines := lineOffsets(src)
+
+	type span struct {
+		start, end int
+		text       string
+	}
+
+	var applied []span
+	unfixed := Messages{}
+
+next:
+	for _, m := range msgs {
+		if len(m.Fix) == 0 {
+			unfixed = append(unfixed, m)
+			continue
+		}
+
+		spans := make([]span, len(m.Fix))
+		for i, e := range m.Fix {
+			start, ok1 := offset(lines, len(src), e.Pos)
+			end, ok2 := offset(lines, len(src), e.End)
+			if !ok1 || !ok2 || end < start {
+				unfixed = append(unfixed, m)
+				continue next
+			}
+			spans[i] = span{start: start, end: end, text: e.Text}
+		}
+
+		for _, s := range spans {
+			for _, a := range applied {
+				if s.start == a.start || (s.start < a.end && a.start < s.end) {
+					unfixed = append(unfixed, m)
+					continue next
+				}
+			}
+		}
+
+		applied = append(applied, spans...)
+	}
+
+	// Apply the edits from the end of the file backwards so that earlier
+	// offsets remain valid.
+	slices.SortFunc(applied, func(a, b span) int { return b.start - a.start })
+
+	out := slices.Clone(src)
+	for _, s := range applied {
+		out = slices.Concat(out[:s.start], []byte(s.text), out[s.end:])
+	}
+
+	return out, unfixed
+}
+
+// lineOffsets returns the byte offset of the start of each line in src.
+func lineOffsets(src []byte) []int {
+	offsets := []int{0}
+	for i := 0; ; {
+		j := bytes.IndexByte(src[i:], '\n')
+		if j < 0 {
+			return offsets
+		}
+		i += j + 1
+		offsets = append(offsets, i)
+	}
+}
+
+// offset converts a 1-based line and column position to a byte offset.
+func offset(lines []int, size int, pos ast.Position) (int, bool) {
+	if pos.Line < 1 || pos.Line > len(lines) || pos.Column < 1 {
+		return 0, false
+	}
+	o := lines[pos.Line-1] + pos.Column - 1
+	if o > size {
+		return 0, false
+	}
+	return o, true
+}
@@ -0,0 +1,130 @@
+// Copyright 2025 Pinterest
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thriftcheck
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"go.uber.org/thriftrw/ast"
+)
+
+func insert(line, col int, text string) Edit {
+	pos := ast.Position{Line: line, Column: col}
+	return Edit{Pos: pos, End: pos, Text: text}
+}
+
+func replace(line, col, endCol int, text string) Edit {
+	return Edit{Pos: ast.Position{Line: line, Column: col}, End: ast.Position{Line: line, Column: endCol}, Text: text}
+}
+
+func TestApplyFixes(t *testing.T) {
+	src := "struct S {\n  string a\n\ti32 b\n}\n"
+
+	tests := []struct {
+		desc    string
+		msgs    Messages
+		want    string
+		unfixed []int
+	}{
+		{
+			desc:    "no fixes",
+			msgs:    Messages{{Message: "a"}},
+			want:    src,
+			unfixed: []int{0},
+		},
+		{
+			desc: "insertions",
+			msgs: Messages{{Fix: []Edit{insert(2, 3, "1: ")}}, {Fix: []Edit{insert(3, 2, "2: ")}}},
+			want: "struct S {\n  1: string a\n\t2: i32 b\n}\n",
+		},
+		{
+			desc: "replacement",
+			msgs: Messages{{Fix: []Edit{replace(1, 8, 9, "T")}}},
+			want: "struct T {\n  string a\n\ti32 b\n}\n",
+		},
+		{
+			desc: "multiple edits",
+			msgs: Messages{{Fix: []Edit{insert(3, 2, "2: "), insert(2, 3, "1: ")}}},
+			want: "struct S {\n  1: string a\n\t2: i32 b\n}\n",
+		},
+		{
+			desc:    "overlapping",
+			msgs:    Messages{{Fix: []Edit{replace(2, 3, 9, "binary")}}, {Fix: []Edit{replace(2, 5, 7, "x")}}, {Fix: []Edit{insert(3, 2, "2: ")}}},
+			want:    "struct S {\n  binary a\n\t2: i32 b\n}\n",
+			unfixed: []int{1},
+		},
+		{
+			desc:    "same position",
+			msgs:    Messages{{Fix: []Edit{insert(2, 3, "1: ")}}, {Fix: []Edit{insert(2, 3, "optional ")}}},
+			want:    "struct S {\n  1: string a\n\ti32 b\n}\n",
+			unfixed: []int{1},
+		},
+		{
+			desc:    "all or nothing",
+			msgs:    Messages{{Fix: []Edit{insert(2, 3, "1: ")}}, {Fix: []Edit{insert(3, 2, "2: "), insert(2, 3, "x")}}},
+			want:    "struct S {\n  1: string a\n\ti32 b\n}\n",
+			unfixed: []int{1},
+		},
+		{
+			desc:    "invalid positions",
+			msgs:    Messages{{Fix: []Edit{insert(0, 0, "x")}}, {Fix: []Edit{insert(9, 1, "x")}}, {Fix: []Edit{replace(1, 5, 3, "x")}}},
+			want:    src,
+			unfixed: []int{0, 1, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			out, unfixed := ApplyFixes([]byte(src), tt.msgs)
+			if string(out) != tt.want {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.want, out)
+			}
+
+			want := Messages{}
+			for _, i := range tt.unfixed {
+				want = append(want, tt.msgs[i])
+			}
+			if !reflect.DeepEqual(unfixed, want) {
+				t.Errorf("expected unfixed messages %v, got %v", want, unfixed)
+			}
+		})
+	}
+}
+
+func TestWithFix(t *testing.T) {
+	linter := NewLinter(Checks{
+		NewCheck("field", func(c *C, f *ast.Field) {
+			c.Warningf(f, "field").WithFix(c.InsertBefore(f.Type, "optional "))
+		}),
+	})
+
+	src := "struct S {\n  1: string a\n}\n"
+	msgs, err := linter.Lint(strings.NewReader(src), "fix.thrift")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []Edit{insert(2, 6, "optional ")}
+	if len(msgs) != 1 || !reflect.DeepEqual(msgs[0].Fix, want) {
+		t.Fatalf("expected a message with fix %v, got %#v", want, msgs)
+	}
+
+	out, _ := ApplyFixes([]byte(src), msgs)
+	if got := string(out); got != "struct S {\n  1: optional string a\n}\n" {
+		t.Errorf("unexpected output:\n%s", got)
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2025 Pinterest
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thriftcheck
+
+import (
+	"path/filepath"
+	"slices"
+
+	"go.uber.org/thriftrw/ast"
+)
+
+// IncludeGraph is a directed graph of the `include` relationships between a
+// set of Thrift files and all of the files they transitively include. Files
+// are identified by their absolute paths.
+type IncludeGraph struct {
+	includes   map[string][]string
+	includedBy map[string][]string
+}
+
+// NewIncludeGraph builds an IncludeGraph rooted at the given files. Files that
+// can't be parsed and includes that can't be located are left out of the graph.
+func NewIncludeGraph(parser *FileParser, filenames []string) *IncludeGraph {
+	g := &IncludeGraph{
+		includes:   make(map[string][]string),
+		includedBy: make(map[string][]string),
+	}
+
+	var queue []string
+	for _, filename := range filenames {
+		if path, err := filepath.Abs(filename); err == nil {
+			queue = append(queue, path)
+		}
+	}
+
+	for len(queue) > 0 {
+		filename := queue[0]
+		queue = queue[1:]
+		if _, ok := g.includes[filename]; ok {
+			continue
+		}
+		g.includes[filename] = nil
+
+		program, _, err := parser.ParseFile(filename)
+		if err != nil {
+			continue
+		}
+
+		dir := filepath.Dir(filename)
+		for _, header := range program.Headers {
+			include, ok := header.(*ast.Include)
+			if !ok {
+				continue
+			}
+			path, err := parser.Locate(include.Path, dir)
+			if err != nil || slices.Contains(g.includes[filename], path) {
+				continue
+			}
+			g.includes[filename] = append(g.includes[filename], path)
+			g.includedBy[path] = append(g.includedBy[path], filename)
+			queue = append(queue, path)
+		}
+	}
+
+	return g
+}
+
+// Files returns the sorted paths of all of the files in the graph.
+func (g *IncludeGraph) Files() []string {
+	files := make([]string, 0, len(g.includes))
+	for filename := range g.includes {
+		files = append(files, filename)
+	}
+	slices.Sort(files)
+	return files
+}
+
+// Includes returns the paths of the files directly included by a file, in
+// the order in which they're included.
+func (g *IncludeGraph) Includes(filename string) []string {
+	if path, err := filepath.Abs(filename); err == nil {
+		return g.includes[path]
+	}
+	return nil
+}
+
+// Dependents returns the sorted paths of the given files and all of the files
+// in the graph that transitively include any of them.
+func (g *IncludeGraph) Dependents(filenames ...string) []string {
+	seen := make(map[string]bool)
+	var visit func(string)
+	visit = func(filename string) {
+		if seen[filename] {
+			return
+		}
+		seen[filename] = true
+		for _, parent := range g.includedBy[filename] {
+			visit(parent)
+		}
+	}
+	for _, filename := range filenames {
+		if path, err := filepath.Abs(filename); err == nil {
+			visit(path)
+		}
+	}
+
+	dependents := make([]string, 0, len(seen))
+	for filename := range seen {
+		dependents = append(dependents, filename)
+	}
+	slices.Sort(dependents)
+	return dependents
+}
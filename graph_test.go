@@ -0,0 +1,94 @@
+// Copyright 2025 Pinterest
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thriftcheck
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestIncludeGraph(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"a.thrift":        `include "b.thrift" include "shared/c.thrift"`,
+		"b.thrift":        `include "shared/c.thrift"`,
+		"shared/c.thrift": `include "d.thrift" include "missing.thrift"`,
+		"shared/d.thrift": `struct D {}`,
+		"e.thrift":        `struct E {}`,
+	})
+	path := func(name string) string { return filepath.Join(dir, name) }
+
+	g := NewIncludeGraph(NewFileParser(nil), []string{path("a.thrift"), path("e.thrift")})
+
+	files := []string{
+		path("a.thrift"),
+		path("b.thrift"),
+		path("e.thrift"),
+		path("shared/c.thrift"),
+		path("shared/d.thrift"),
+	}
+	if got := g.Files(); !slices.Equal(got, files) {
+		t.Errorf("Files() = %v, want %v", got, files)
+	}
+
+	includes := []string{path("b.thrift"), path("shared/c.thrift")}
+	if got := g.Includes(path("a.thrift")); !slices.Equal(got, includes) {
+		t.Errorf("Includes(a) = %v, want %v", got, includes)
+	}
+
+	dependents := []string{
+		path("a.thrift"),
+		path("b.thrift"),
+		path("shared/c.thrift"),
+		path("shared/d.thrift"),
+	}
+	if got := g.Dependents(path("shared/d.thrift")); !slices.Equal(got, dependents) {
+		t.Errorf("Dependents(d) = %v, want %v", got, dependents)
+	}
+
+	dependents = []string{path("e.thrift")}
+	if got := g.Dependents(path("e.thrift")); !slices.Equal(got, dependents) {
+		t.Errorf("Dependents(e) = %v, want %v", got, dependents)
+	}
+}
+
+func TestIncludeGraphCycle(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"a.thrift": `include "b.thrift"`,
+		"b.thrift": `include "a.thrift"`,
+	})
+	a, b := filepath.Join(dir, "a.thrift"), filepath.Join(dir, "b.thrift")
+
+	g := NewIncludeGraph(NewFileParser(nil), []string{a})
+	if got, want := g.Dependents(a), []string{a, b}; !slices.Equal(got, want) {
+		t.Errorf("Dependents(a) = %v, want %v", got, want)
+	}
+}
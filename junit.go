@@ -0,0 +1,110 @@
+// Copyright 2025 Pinterest
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thriftcheck
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// JUnitReporter is a Reporter that writes Messages using the JUnit XML
+// format. Each check is represented by a test case, which fails if the check
+// reported any messages.
+type JUnitReporter struct {
+	Checks Checks
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// Report writes msgs to w.
+func (r JUnitReporter) Report(w io.Writer, msgs Messages) error {
+	suite := junitTestSuite{Name: "thriftcheck"}
+
+	cases := make(map[string]int, len(r.Checks))
+	addCase := func(name string) int {
+		if i, ok := cases[name]; ok {
+			return i
+		}
+		cases[name] = len(suite.Cases)
+		suite.Cases = append(suite.Cases, junitTestCase{Name: name, ClassName: "thriftcheck"})
+		return cases[name]
+	}
+
+	for _, name := range r.Checks.SortedNames() {
+		addCase(name)
+	}
+
+	results := make(map[int]Messages)
+	for _, m := range msgs {
+		i := addCase(m.Check)
+		results[i] = append(results[i], m)
+	}
+
+	for i := range suite.Cases {
+		failed := results[i]
+		if len(failed) == 0 {
+			continue
+		}
+
+		severity := Warning
+		lines := make([]string, len(failed))
+		for j, m := range failed {
+			severity = max(severity, m.Severity)
+			lines[j] = m.String()
+		}
+
+		suite.Cases[i].Failure = &junitFailure{
+			Message: fmt.Sprintf("%d problem(s) reported", len(failed)),
+			Type:    severity.String(),
+			Text:    strings.Join(lines, "\n"),
+		}
+		suite.Failures++
+	}
+	suite.Tests = len(suite.Cases)
+
+	return writeXML(w, junitTestSuites{
+		Name:     suite.Name,
+		Tests:    suite.Tests,
+		Failures: suite.Failures,
+		Suites:   []junitTestSuite{suite},
+	})
+}
@@ -0,0 +1,55 @@
+// Copyright 2025 Pinterest
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thriftcheck
+
+import (
+	"bytes"
+	"testing"
+
+	"go.uber.org/thriftrw/ast"
+)
+
+func TestJUnitReporter(t *testing.T) {
+	checks := Checks{
+		NewCheck("type.check", func(c *C, n ast.Node) {}),
+		NewCheck("field.check", func(c *C, f *ast.Field) {}),
+		NewCheck("passing.check", func(c *C, n ast.Node) {}),
+	}
+
+	var buf bytes.Buffer
+	if err := (JUnitReporter{Checks: checks}).Report(&buf, testMessages); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites name="thriftcheck" tests="4" failures="3">
+  <testsuite name="thriftcheck" tests="4" failures="3">
+    <testcase name="field.check" classname="thriftcheck">
+      <failure message="1 problem(s) reported" type="warning">a.thrift:5:1: warning: Warning (field.check)</failure>
+    </testcase>
+    <testcase name="passing.check" classname="thriftcheck"></testcase>
+    <testcase name="type.check" classname="thriftcheck">
+      <failure message="1 problem(s) reported" type="error">b.thrift:2:3: error: Error (type.check)</failure>
+    </testcase>
+    <testcase name="parse" classname="thriftcheck">
+      <failure message="1 problem(s) reported" type="error">b.thrift:1:1: error: syntax error (parse)</failure>
+    </testcase>
+  </testsuite>
+</testsuites>
+`
+	if got := buf.String(); got != want {
+		t.Errorf("expected:\n%s\ngot:\n%s", want, got)
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"go.uber.org/thriftrw/ast"
 	"go.uber.org/thriftrw/idl"
@@ -29,10 +30,12 @@ import (
 
 // Linter is a configured Thrift linter.
 type Linter struct {
-	checks   Checks
-	parser   *FileParser
-	logger   *log.Logger
-	includes []string
+	checks      Checks
+	parser      *FileParser
+	logger      *log.Logger
+	includes    []string
+	concurrency int
+	previous    *previousRevision
 }
 
 // Option represents a Linter option.
@@ -54,22 +57,53 @@ func WithIncludes(includes []string) Option {
 	}
 }
 
+// WithConcurrency is an Option that sets the maximum number of files that
+// LintFiles will lint in parallel. Values less than 1 are treated as 1.
+func WithConcurrency(n int) Option {
+	return func(l *Linter) {
+		l.concurrency = max(n, 1)
+	}
+}
+
+// WithPrevious is an Option that locates a previous revision of the linted
+// files in the given root directory. Each file's previous revision is found
+// beneath root at the file's path relative to base, which is typically the
+// directory being linted, and is made available to checks as [C.Previous].
+// This allows compatibility checks to compare the two. An empty base is the
+// current directory.
+func WithPrevious(root, base string) Option {
+	return func(l *Linter) {
+		l.previous = &previousRevision{root: filepath.Clean(root), base: filepath.Clean(base)}
+	}
+}
+
 // NewLinter creates a new Linter configured with the given checks and options.
 func NewLinter(checks Checks, options ...Option) *Linter {
 	l := &Linter{
-		checks: checks,
-		logger: log.New(io.Discard, "", 0),
+		checks:      checks,
+		logger:      log.New(io.Discard, "", 0),
+		concurrency: 1,
 	}
 	for _, option := range options {
 		option(l)
 	}
 	l.parser = NewFileParser(l.includes)
+	if l.previous != nil {
+		l.previous.parser = NewFileParser(nil)
+		l.logger.Printf("previous: %s (for %s)\n", l.previous.root, l.previous.base)
+	}
 	l.logger.Printf("checks: %s\n", checks)
 	l.logger.Printf("includes: %s\n", strings.Join(l.includes, " "))
 	return l
 }
 
-// Lint lints a single input file.
+// Parser returns the FileParser used by the linter, which caches the files it
+// has parsed.
+func (l *Linter) Parser() *FileParser {
+	return l.parser
+}
+
+// Lint lints a single input file. Project checks are only run by LintFiles.
 func (l *Linter) Lint(r io.Reader, filename string) (Messages, error) {
 	program, info, err := l.parser.Parse(r, filename)
 	if err != nil {
@@ -92,27 +126,75 @@ func (l *Linter) Lint(r io.Reader, filename string) (Messages, error) {
 	return l.lint(program, filename, info), nil
 }
 
-// LintFiles lints multiple files. Each is opened, parsed, and linted in
-// order, and the aggregate result is returned.
+// LintFiles lints multiple files. Each is opened, parsed, and linted, and the
+// aggregate result is returned in the order the files were given, followed by
+// the messages reported by any project checks.
+//
+// Files are linted in parallel when the Linter is configured using the
+// WithConcurrency option. If any file fails, the messages for the files that
+// precede it are returned along with its error.
 func (l *Linter) LintFiles(filenames []string) (Messages, error) {
-	msgs := Messages{}
+	results := make([]Messages, len(filenames))
+	errs := make([]error, len(filenames))
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range min(l.concurrency, len(filenames)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i], errs[i] = l.lintFile(filenames[i])
+			}
+		}()
+	}
+	for i := range filenames {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
 
-	for _, filename := range filenames {
-		f, err := os.Open(filename)
-		if err != nil {
-			return msgs, fmt.Errorf("%s: %w", filename, err)
+	msgs := Messages{}
+	for i := range filenames {
+		if errs[i] != nil {
+			return msgs, errs[i]
 		}
-		defer f.Close()
+		msgs = append(msgs, results[i]...)
+	}
+
+	return append(msgs, l.lintProject(filenames)...), nil
+}
 
-		m, err := l.Lint(f, filename)
-		if err != nil {
-			return msgs, err
+// lintProject runs the project checks over the given files, which have already
+// been linted individually, and all of the files they include.
+func (l *Linter) lintProject(filenames []string) Messages {
+	var checks Checks
+	for _, check := range l.checks {
+		if check.IsProject() {
+			checks = append(checks, check)
 		}
+	}
+	if len(checks) == 0 {
+		return nil
+	}
+
+	l.logger.Printf("linting project (%d files)\n", len(filenames))
 
-		msgs = append(msgs, m...)
+	p := newProject(filenames, l.parser, l.logger)
+	for _, check := range checks {
+		check.CallProject(p)
 	}
+	return p.Messages
+}
+
+func (l *Linter) lintFile(filename string) (Messages, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+	defer f.Close()
 
-	return msgs, nil
+	return l.Lint(f, filename)
 }
 
 func (l *Linter) lint(program *ast.Program, filename string, parseInfo *idl.Info) (messages Messages) {
@@ -122,6 +204,7 @@ func (l *Linter) lint(program *ast.Program, filename string, parseInfo *idl.Info
 		Filename:  filename,
 		Dirs:      append([]string{filepath.Dir(filename)}, l.includes...),
 		Program:   program,
+		Previous:  l.previous.program(filename, l.logger),
 		logger:    l.logger,
 		parser:    l.parser,
 		parseInfo: parseInfo,
@@ -143,6 +226,7 @@ func (l *Linter) lint(program *ast.Program, filename string, parseInfo *idl.Info
 		}
 
 		// Run all of the checks that match this part of the tree.
+		ctx.nodes = nodes
 		for _, check := range checks {
 			check.Call(ctx, nodes...)
 		}
@@ -154,6 +238,51 @@ func (l *Linter) lint(program *ast.Program, filename string, parseInfo *idl.Info
 	return ctx.Messages
 }
 
+// previousRevision locates and parses the previous revision of linted files.
+type previousRevision struct {
+	root   string
+	base   string
+	parser *FileParser
+}
+
+// program returns the previous revision of the given file, or nil if it
+// doesn't exist or can't be parsed.
+func (p *previousRevision) program(filename string, logger *log.Logger) *ast.Program {
+	if p == nil {
+		return nil
+	}
+
+	abs, err := filepath.Abs(filename)
+	if err != nil {
+		return nil
+	}
+	base, err := filepath.Abs(p.base)
+	if err != nil {
+		return nil
+	}
+	rel, err := filepath.Rel(base, abs)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		logger.Printf("previous revision of %s: not beneath %s\n", filename, p.base)
+		return nil
+	}
+
+	path, err := filepath.Abs(filepath.Join(p.root, rel))
+	if err != nil {
+		return nil
+	}
+	if _, err := os.Stat(path); err != nil {
+		logger.Printf("previous revision of %s: %s doesn't exist; not comparing\n", filename, path)
+		return nil
+	}
+
+	program, _, err := p.parser.ParseFile(path)
+	if err != nil {
+		logger.Printf("previous revision of %s: %s\n", filename, err)
+		return nil
+	}
+	return program
+}
+
 // Stores Checks overrides that apply to a node and all of its children.
 type overridableChecks struct {
 	root      *Checks
@@ -15,8 +15,11 @@
 package thriftcheck
 
 import (
+	"fmt"
 	"io"
 	"log"
+	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
@@ -41,6 +44,55 @@ func TestWithIncludes(t *testing.T) {
 	}
 }
 
+func TestWithConcurrency(t *testing.T) {
+	tests := []struct {
+		n, want int
+	}{
+		{-1, 1},
+		{0, 1},
+		{1, 1},
+		{8, 8},
+	}
+
+	for _, tt := range tests {
+		linter := NewLinter(Checks{}, WithConcurrency(tt.n))
+		if linter.concurrency != tt.want {
+			t.Errorf("WithConcurrency(%d): expected %d, got %d", tt.n, tt.want, linter.concurrency)
+		}
+	}
+}
+
+func TestWithPrevious(t *testing.T) {
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "a.thrift"), []byte(testStructContent), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	var previous *ast.Program
+	linter := NewLinter(Checks{
+		NewCheck("program", func(c *C, p *ast.Program) { previous = c.Previous }),
+	}, WithPrevious(root, "new"))
+
+	tests := []struct {
+		filename string
+		found    bool
+	}{
+		{"new/a.thrift", true},
+		{"new/b.thrift", false},
+		{"a.thrift", false},
+	}
+
+	for _, tt := range tests {
+		previous = nil
+		if _, err := linter.Lint(strings.NewReader(testStructContent), tt.filename); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if found := previous != nil; found != tt.found {
+			t.Errorf("%s: expected previous revision found=%v, got %v", tt.filename, tt.found, found)
+		}
+	}
+}
+
 func TestLint(t *testing.T) {
 	linter := NewLinter(Checks{
 		NewCheck("node", func(c *C, n ast.Node) { c.Errorf(n, "node") }),
@@ -81,6 +133,99 @@ func TestLint(t *testing.T) {
 	}
 }
 
+func TestLintDefinition(t *testing.T) {
+	linter := NewLinter(Checks{
+		NewCheck("field", func(c *C, f *ast.Field) { c.Errorf(f, "field") }),
+	})
+
+	s := strings.NewReader(`
+		struct A {
+			1: string id
+		}
+
+		service S {
+			void f(1: string id)
+		}
+	`)
+
+	msgs, err := linter.Lint(s, "t.thrift")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got []string
+	for _, m := range msgs {
+		got = append(got, m.Definition)
+	}
+	if want := []string{"A", "S"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected definitions %v; got %v", want, got)
+	}
+	if msgs[0].Fingerprint() == msgs[1].Fingerprint() {
+		t.Errorf("expected fingerprints in different definitions to differ")
+	}
+}
+
+func TestLintFiles(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	var filenames []string
+	for i := range 20 {
+		filename := filepath.Join(tmpDir, fmt.Sprintf("t%02d.thrift", i))
+		content := fmt.Sprintf("struct S%d { 1: string field }", i)
+		if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write test file: %v", err)
+		}
+		filenames = append(filenames, filename)
+	}
+
+	checks := Checks{
+		NewCheck("struct", func(c *C, s *ast.Struct) { c.Warningf(s, "%s", s.Name) }),
+	}
+
+	for _, n := range []int{1, 4, 32} {
+		linter := NewLinter(checks, WithConcurrency(n))
+		msgs, err := linter.LintFiles(filenames)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(msgs) != len(filenames) {
+			t.Fatalf("concurrency %d: expected %d messages, got %d", n, len(filenames), len(msgs))
+		}
+		for i, m := range msgs {
+			if m.Filename != filenames[i] || m.Message != fmt.Sprintf("S%d", i) {
+				t.Errorf("concurrency %d: message %d out of order: %s", n, i, m)
+			}
+		}
+	}
+}
+
+func TestLintFilesError(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	good := filepath.Join(tmpDir, "good.thrift")
+	if err := os.WriteFile(good, []byte(testStructContent), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+	missing := filepath.Join(tmpDir, "missing.thrift")
+
+	checks := Checks{
+		NewCheck("struct", func(c *C, s *ast.Struct) { c.Warningf(s, "struct") }),
+	}
+
+	linter := NewLinter(checks, WithConcurrency(4))
+	msgs, err := linter.LintFiles([]string{good, missing, good})
+	if err == nil {
+		t.Fatal("expected an error for the missing file")
+	}
+	if !strings.Contains(err.Error(), missing) {
+		t.Errorf("expected error to name %s, got: %v", missing, err)
+	}
+	if len(msgs) != 1 || msgs[0].Filename != good {
+		t.Errorf("expected only the preceding file's messages, got %v", msgs)
+	}
+}
+
 func TestParseError(t *testing.T) {
 	tests := []struct {
 		s    string
@@ -0,0 +1,263 @@
+// Copyright 2025 Pinterest
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package lsp implements a Language Server Protocol server that publishes
+// thriftcheck messages as diagnostics.
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/pinterest/thriftcheck"
+)
+
+// Server is a Language Server Protocol server. It lints open documents using
+// a long-lived thriftcheck.Linter whenever they are opened or changed.
+type Server struct {
+	linter  *thriftcheck.Linter
+	version string
+	logger  *log.Logger
+	docs    map[string]string
+	w       io.Writer
+}
+
+// NewServer returns a new Server that lints documents using the given linter.
+// The version is reported to the client.
+func NewServer(linter *thriftcheck.Linter, version string, logger *log.Logger) *Server {
+	if logger == nil {
+		logger = log.New(io.Discard, "", 0)
+	}
+	return &Server{
+		linter:  linter,
+		version: version,
+		logger:  logger,
+		docs:    make(map[string]string),
+	}
+}
+
+// errExit is returned by handle when the client asks the server to exit.
+var errExit = errors.New("exit")
+
+// Serve reads requests from r and writes responses and notifications to w
+// until the client sends an "exit" notification or r is closed.
+func (s *Server) Serve(r io.Reader, w io.Writer) error {
+	s.w = w
+	br := bufio.NewReader(r)
+
+	for {
+		msg, err := readMessage(br)
+		if err != nil {
+			var rerr *responseError
+			if errors.As(err, &rerr) {
+				if err := s.reply(msg, nil, rerr); err != nil {
+					return err
+				}
+				continue
+			}
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		if err := s.handle(msg); err != nil {
+			if errors.Is(err, errExit) {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+func (s *Server) handle(msg *message) error {
+	s.logger.Printf("lsp: %s\n", msg.Method)
+
+	switch msg.Method {
+	case "initialize":
+		return s.reply(msg, initializeResult{
+			Capabilities: serverCapabilities{
+				TextDocumentSync: textDocumentSyncOptions{
+					OpenClose: true,
+					Change:    textDocumentSyncFull,
+					Save:      true,
+				},
+			},
+			ServerInfo: serverInfo{Name: "thriftcheck", Version: s.version},
+		}, nil)
+
+	case "shutdown":
+		return s.reply(msg, nil, nil)
+
+	case "exit":
+		return errExit
+
+	case "textDocument/didOpen":
+		var params didOpenTextDocumentParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return s.invalidParams(msg, err)
+		}
+		s.docs[params.TextDocument.URI] = params.TextDocument.Text
+		return s.lint(params.TextDocument.URI)
+
+	case "textDocument/didChange":
+		var params didChangeTextDocumentParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return s.invalidParams(msg, err)
+		}
+		if n := len(params.ContentChanges); n > 0 {
+			s.docs[params.TextDocument.URI] = params.ContentChanges[n-1].Text
+		}
+		return s.lint(params.TextDocument.URI)
+
+	case "textDocument/didSave":
+		var params didSaveTextDocumentParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return s.invalidParams(msg, err)
+		}
+		return s.lint(params.TextDocument.URI)
+
+	case "textDocument/didClose":
+		var params didCloseTextDocumentParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return s.invalidParams(msg, err)
+		}
+		delete(s.docs, params.TextDocument.URI)
+		if filename, ok := uriToFilename(params.TextDocument.URI); ok {
+			s.linter.Parser().ClearOverlay(filename)
+		}
+		return s.publish(params.TextDocument.URI, []diagnostic{})
+	}
+
+	// Unknown requests receive an error, but unknown notifications (which
+	// don't have an ID) are ignored.
+	if msg.ID != nil {
+		return s.reply(msg, nil, &responseError{Code: codeMethodNotFound, Message: "method not found: " + msg.Method})
+	}
+	return nil
+}
+
+// invalidParams handles a message whose params couldn't be decoded. Requests
+// receive an error, while notifications (which can't be answered) are logged
+// and otherwise ignored.
+func (s *Server) invalidParams(msg *message, err error) error {
+	if msg.ID != nil {
+		return s.reply(msg, nil, &responseError{Code: codeInvalidParams, Message: "invalid params: " + err.Error()})
+	}
+	s.logger.Printf("lsp: %s: invalid params: %s\n", msg.Method, err)
+	return nil
+}
+
+// lint lints an open document and publishes the resulting diagnostics.
+func (s *Server) lint(uri string) error {
+	text, ok := s.docs[uri]
+	if !ok {
+		return nil
+	}
+	filename, ok := uriToFilename(uri)
+	if !ok {
+		s.logger.Printf("lsp: unsupported URI: %s\n", uri)
+		return nil
+	}
+
+	// Open documents are overlaid on the parser so that other documents
+	// which include them see their latest (unsaved) text.
+	s.linter.Parser().SetOverlay(filename, []byte(text))
+	msgs, err := s.linter.Lint(strings.NewReader(text), filename)
+	if err != nil {
+		s.logger.Printf("lsp: %s\n", err)
+		return nil
+	}
+
+	lines := strings.Split(text, "\n")
+	diagnostics := make([]diagnostic, 0, len(msgs))
+	for _, m := range msgs {
+		diagnostics = append(diagnostics, newDiagnostic(m, lines))
+	}
+	return s.publish(uri, diagnostics)
+}
+
+func (s *Server) publish(uri string, diagnostics []diagnostic) error {
+	params, err := json.Marshal(publishDiagnosticsParams{URI: uri, Diagnostics: diagnostics})
+	if err != nil {
+		return err
+	}
+	return writeMessage(s.w, &message{Method: "textDocument/publishDiagnostics", Params: params})
+}
+
+func (s *Server) reply(req *message, result any, rerr *responseError) error {
+	resp := &message{ID: req.ID, Error: rerr}
+	if resp.ID == nil {
+		resp.ID = json.RawMessage("null")
+	}
+	if rerr == nil {
+		b, err := json.Marshal(result)
+		if err != nil {
+			return err
+		}
+		resp.Result = b
+	}
+	return writeMessage(s.w, resp)
+}
+
+// newDiagnostic converts a message to a diagnostic. Messages only have a
+// starting position, so the diagnostic's range extends to the end of the line.
+func newDiagnostic(m thriftcheck.Message, lines []string) diagnostic {
+	start := position{Line: max(m.Pos.Line-1, 0), Character: max(m.Pos.Column-1, 0)}
+	end := start
+	if start.Line < len(lines) {
+		line := strings.TrimRight(lines[start.Line], "\r")
+		start.Character = utf16Len(line[:min(start.Character, len(line))])
+		end.Character = max(utf16Len(line), start.Character)
+	}
+
+	severity := severityWarning
+	if m.Severity == thriftcheck.Error {
+		severity = severityError
+	}
+
+	return diagnostic{
+		Range:    lspRange{Start: start, End: end},
+		Severity: severity,
+		Code:     m.Check,
+		Source:   "thriftcheck",
+		Message:  m.Message,
+	}
+}
+
+// utf16Len returns the length of s in UTF-16 code units, which is how LSP
+// measures character offsets by default.
+func utf16Len(s string) int {
+	n := 0
+	for _, r := range s {
+		n += utf16.RuneLen(r)
+	}
+	return n
+}
+
+// uriToFilename converts a "file" URI to a local filename.
+func uriToFilename(uri string) (string, bool) {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "file" {
+		return "", false
+	}
+	return filepath.FromSlash(u.Path), true
+}
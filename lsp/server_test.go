@@ -0,0 +1,221 @@
+// Copyright 2025 Pinterest
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lsp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/pinterest/thriftcheck"
+	"go.uber.org/thriftrw/ast"
+)
+
+func writeTestMessage(t *testing.T, w *bytes.Buffer, id any, method string, params any) {
+	t.Helper()
+
+	msg := &message{Method: method}
+	if id != nil {
+		b, _ := json.Marshal(id)
+		msg.ID = b
+	}
+	if params != nil {
+		b, err := json.Marshal(params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		msg.Params = b
+	}
+	if err := writeMessage(w, msg); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readTestMessages(t *testing.T, r *bytes.Buffer) []*message {
+	t.Helper()
+
+	var msgs []*message
+	br := bufio.NewReader(r)
+	for {
+		msg, err := readMessage(br)
+		if err != nil {
+			return msgs
+		}
+		msgs = append(msgs, msg)
+	}
+}
+
+func TestServer(t *testing.T) {
+	linter := thriftcheck.NewLinter(thriftcheck.Checks{
+		thriftcheck.NewCheck("field.id.zero", func(c *thriftcheck.C, f *ast.Field) {
+			if f.ID == 0 {
+				c.Errorf(f, "field ID for %q is zero", f.Name)
+			}
+		}),
+	})
+
+	filename, _ := filepath.Abs("server_test.thrift")
+	uri := "file://" + filepath.ToSlash(filename)
+
+	var in, out bytes.Buffer
+	writeTestMessage(t, &in, 1, "initialize", map[string]any{})
+	writeTestMessage(t, &in, nil, "initialized", map[string]any{})
+	writeTestMessage(t, &in, nil, "textDocument/didOpen", didOpenTextDocumentParams{
+		TextDocument: textDocumentItem{URI: uri, Text: "struct S {\n  /* é */ 0: string a\n}\n"},
+	})
+	writeTestMessage(t, &in, nil, "textDocument/didChange", didChangeTextDocumentParams{
+		TextDocument:   textDocumentIdentifier{URI: uri},
+		ContentChanges: []textDocumentContentChangeEvent{{Text: "struct S {\n  /* é */ 1: string a\n}\n"}},
+	})
+	writeTestMessage(t, &in, 2, "textDocument/hover", map[string]any{})
+	writeTestMessage(t, &in, nil, "textDocument/didClose", didCloseTextDocumentParams{
+		TextDocument: textDocumentIdentifier{URI: uri},
+	})
+	writeTestMessage(t, &in, 3, "shutdown", nil)
+	writeTestMessage(t, &in, nil, "exit", nil)
+
+	if err := NewServer(linter, "1.2.3", nil).Serve(&in, &out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	msgs := readTestMessages(t, &out)
+	if len(msgs) != 6 {
+		t.Fatalf("expected 6 messages, got %d", len(msgs))
+	}
+
+	// initialize
+	var result initializeResult
+	if err := json.Unmarshal(msgs[0].Result, &result); err != nil {
+		t.Fatalf("invalid initialize result: %v", err)
+	}
+	if string(msgs[0].ID) != "1" || result.ServerInfo.Version != "1.2.3" || result.Capabilities.TextDocumentSync.Change != textDocumentSyncFull {
+		t.Errorf("unexpected initialize response: %s", msgs[0].Result)
+	}
+
+	// didOpen, didChange
+	wantDiagnostics := [][]diagnostic{
+		{{
+			Range:    lspRange{Start: position{Line: 1, Character: 10}, End: position{Line: 1, Character: 21}},
+			Severity: severityError,
+			Code:     "field.id.zero",
+			Source:   "thriftcheck",
+			Message:  `field ID for "a" is zero`,
+		}},
+		{},
+	}
+	for i, want := range wantDiagnostics {
+		msg := msgs[i+1]
+		var params publishDiagnosticsParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			t.Fatalf("invalid diagnostics: %v", err)
+		}
+		if msg.Method != "textDocument/publishDiagnostics" || params.URI != uri {
+			t.Errorf("unexpected notification: %s %s", msg.Method, msg.Params)
+		}
+		if !reflect.DeepEqual(params.Diagnostics, want) {
+			t.Errorf("expected diagnostics %+v, got %+v", want, params.Diagnostics)
+		}
+	}
+
+	// hover (unsupported)
+	if string(msgs[3].ID) != "2" || msgs[3].Error == nil || msgs[3].Error.Code != codeMethodNotFound {
+		t.Errorf("expected a method not found error, got %+v", msgs[3])
+	}
+
+	// didClose
+	if msgs[4].Method != "textDocument/publishDiagnostics" {
+		t.Errorf("expected diagnostics to be cleared, got %+v", msgs[4])
+	}
+
+	// shutdown
+	if string(msgs[5].ID) != "3" || string(msgs[5].Result) != "null" || msgs[5].Error != nil {
+		t.Errorf("unexpected shutdown response: %+v", msgs[5])
+	}
+}
+
+func TestServerInvalidMessages(t *testing.T) {
+	var in, out bytes.Buffer
+	in.WriteString("Content-Length: -5\r\n\r\n")
+	in.WriteString("X-Other: 1\r\n\r\n")
+	writeTestMessage(t, &in, nil, "textDocument/didOpen", 1)
+	writeTestMessage(t, &in, 1, "textDocument/didSave", 1)
+	writeTestMessage(t, &in, 2, "shutdown", nil)
+	writeTestMessage(t, &in, nil, "exit", nil)
+
+	if err := NewServer(thriftcheck.NewLinter(nil), "", nil).Serve(&in, &out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	msgs := readTestMessages(t, &out)
+	if len(msgs) != 4 {
+		t.Fatalf("expected 4 messages, got %d", len(msgs))
+	}
+
+	// Negative and missing Content-Length headers
+	for _, msg := range msgs[:2] {
+		if string(msg.ID) != "null" || msg.Error == nil || msg.Error.Code != codeParseError {
+			t.Errorf("expected a parse error, got %+v", msg)
+		}
+	}
+
+	// didOpen (a notification) is ignored, but didSave (with an ID) is answered
+	if string(msgs[2].ID) != "1" || msgs[2].Error == nil || msgs[2].Error.Code != codeInvalidParams {
+		t.Errorf("expected an invalid params error, got %+v", msgs[2])
+	}
+
+	// shutdown
+	if string(msgs[3].ID) != "2" || msgs[3].Error != nil {
+		t.Errorf("unexpected shutdown response: %+v", msgs[3])
+	}
+}
+
+func TestUTF16Len(t *testing.T) {
+	tests := []struct {
+		s    string
+		want int
+	}{
+		{"", 0},
+		{"abc", 3},
+		{"é", 1},
+		{"😀", 2},
+	}
+
+	for _, tt := range tests {
+		if got := utf16Len(tt.s); got != tt.want {
+			t.Errorf("%q: expected %d, got %d", tt.s, tt.want, got)
+		}
+	}
+}
+
+func TestURIToFilename(t *testing.T) {
+	tests := []struct {
+		uri      string
+		filename string
+		ok       bool
+	}{
+		{"file:///a/b%20c.thrift", filepath.FromSlash("/a/b c.thrift"), true},
+		{"untitled:Untitled-1", "", false},
+	}
+
+	for _, tt := range tests {
+		filename, ok := uriToFilename(tt.uri)
+		if filename != tt.filename || ok != tt.ok {
+			t.Errorf("%s: expected (%q, %v), got (%q, %v)", tt.uri, tt.filename, tt.ok, filename, ok)
+		}
+	}
+}
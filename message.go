@@ -38,21 +38,51 @@ func (s Severity) String() string {
 }
 
 // Message is a message produced by a Check.
+//
+// Definition is the name of the top-level definition that encloses Node, if
+// any. Fix is an optional list of edits that resolve the problem described by
+// the message. The edits are applied together or not at all.
 type Message struct {
-	Filename string
-	Pos      ast.Position
-	Node     ast.Node
-	Check    string
-	Severity Severity
-	Message  string
+	Filename   string
+	Pos        ast.Position
+	Node       ast.Node
+	Definition string
+	Check      string
+	Severity   Severity
+	Message    string
+	Fix        []Edit
+}
+
+// definitionName returns the name of the outermost definition among nodes,
+// which are ordered from a node through its ancestors.
+func definitionName(nodes []ast.Node) string {
+	for i := len(nodes) - 1; i >= 0; i-- {
+		if def, ok := nodes[i].(ast.Definition); ok {
+			return def.Info().Name
+		}
+	}
+	return ""
+}
+
+// Edit is a textual change to a file. Text replaces the content between Pos
+// (inclusive) and End (exclusive). An insertion has an End equal to its Pos.
+type Edit struct {
+	Pos  ast.Position
+	End  ast.Position
+	Text string
 }
 
 func (m Message) String() string {
-	col := m.Pos.Column
-	if col == 0 {
-		col = 1
+	return fmt.Sprintf("%s:%d:%d: %s: %s (%s)", m.Filename, m.Pos.Line, m.column(), m.Severity, m.Message, m.Check)
+}
+
+// column returns the message's 1-based column number. Nodes without column
+// information are reported at the first column.
+func (m Message) column() int {
+	if m.Pos.Column == 0 {
+		return 1
 	}
-	return fmt.Sprintf("%s:%d:%d: %s: %s (%s)", m.Filename, m.Pos.Line, col, m.Severity, m.Message, m.Check)
+	return m.Pos.Column
 }
 
 // Messages is a list of messages.
@@ -15,6 +15,7 @@
 package thriftcheck
 
 import (
+	"reflect"
 	"regexp"
 	"strings"
 
@@ -63,3 +64,35 @@ func splitTrim(s, sep string) []string {
 	}
 	return values
 }
+
+// nolinted reports whether a node in program, or any of its ancestors, has a
+// 'nolint' directive that disables the named check.
+func nolinted(program *ast.Program, node ast.Node, check string) bool {
+	if program == nil || node == nil || !reflect.TypeOf(node).Comparable() {
+		return false
+	}
+
+	var disabled, found bool
+	var visitor VisitorFunc
+	visitor = func(w ast.Walker, n ast.Node) VisitorFunc {
+		if found {
+			return nil
+		}
+		if !reflect.TypeOf(n).Comparable() || n != node {
+			return visitor
+		}
+
+		found = true
+		for _, n := range append([]ast.Node{n}, w.Ancestors()...) {
+			if names, ok := nolint(n); ok {
+				if names == nil || len((Checks{{Name: check}}).Without(names)) == 0 {
+					disabled = true
+				}
+			}
+		}
+		return nil
+	}
+	ast.Walk(visitor, program)
+
+	return disabled
+}
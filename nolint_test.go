@@ -16,6 +16,7 @@ package thriftcheck
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"go.uber.org/thriftrw/ast"
@@ -110,3 +111,41 @@ func TestNolint(t *testing.T) {
 		})
 	}
 }
+
+func TestNolinted(t *testing.T) {
+	program, _, err := Parse(strings.NewReader(`
+struct S {
+	1: string a (nolint = "field")
+	2: string b (nolint = "other")
+	3: string c
+}
+struct T {
+	1: string d
+} (nolint = "")
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := program.Definitions[0].(*ast.Struct)
+	u := program.Definitions[1].(*ast.Struct)
+
+	tests := []struct {
+		node  ast.Node
+		check string
+		want  bool
+	}{
+		{s, "field.name", false},
+		{s.Fields[0], "field.name", true},
+		{s.Fields[0], "fieldname", false},
+		{s.Fields[1], "field.name", false},
+		{s.Fields[1], "other", true},
+		{s.Fields[2], "field.name", false},
+		{u.Fields[0], "field.name", true},
+		{&ast.Struct{}, "field.name", false},
+	}
+	for _, tt := range tests {
+		if got := nolinted(program, tt.node, tt.check); got != tt.want {
+			t.Errorf("nolinted(%v, %q) = %v, want %v", tt.node, tt.check, got, tt.want)
+		}
+	}
+}
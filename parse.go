@@ -15,11 +15,13 @@
 package thriftcheck
 
 import (
+	"crypto/sha256"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 	"sync"
+	"time"
 
 	"go.uber.org/thriftrw/ast"
 	"go.uber.org/thriftrw/idl"
@@ -38,69 +40,202 @@ func Parse(r io.Reader) (*ast.Program, *idl.Info, error) {
 }
 
 type parsedFile struct {
-	prog *ast.Program
-	info *idl.Info
+	prog    *ast.Program
+	info    *idl.Info
+	hash    [sha256.Size]byte
+	modTime time.Time
+	size    int64
 }
 
 // FileParser caches parsed Thrift files to avoid re-parsing included files.
+// Cached results are reused until the file's content changes. Overlays can
+// be used to supply in-memory content (such as an editor's unsaved buffer)
+// in place of a file's on-disk content.
+//
 // It is safe for concurrent use by multiple goroutines.
 type FileParser struct {
-	mu    sync.RWMutex
-	cache map[string]parsedFile
-	dirs  []string
+	mu       sync.RWMutex
+	cache    map[string]parsedFile
+	overlays map[string][]byte
+	dirs     []string
 }
 
 // NewParser returns a new Parser with a list of directories that will be
 // searched for files.
 func NewFileParser(dirs []string) *FileParser {
 	return &FileParser{
-		cache: make(map[string]parsedFile),
-		dirs:  dirs,
+		cache:    make(map[string]parsedFile),
+		overlays: make(map[string][]byte),
+		dirs:     dirs,
 	}
 }
 
-// Parse parses Thrift document content with the given filename.
+// Parse parses Thrift document content with the given filename. The cached
+// result is returned if the content hasn't changed since it was last parsed.
 func (p *FileParser) Parse(r io.Reader, filename string) (*ast.Program, *idl.Info, error) {
 	filename, err := filepath.Abs(filename)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return p.parse(b, filename, nil)
+}
+
+// parse parses content for an absolute filename. If fi is non-nil, the file's
+// modification time and size are recorded so that ParseFile can later reuse
+// the cached result without reading the file.
+func (p *FileParser) parse(b []byte, filename string, fi os.FileInfo) (*ast.Program, *idl.Info, error) {
+	hash := sha256.Sum256(b)
+
 	p.mu.RLock()
 	cached, ok := p.cache[filename]
 	p.mu.RUnlock()
 
-	if ok {
-		return cached.prog, cached.info, nil
+	if !ok || cached.hash != hash {
+		cfg := idl.Config{Info: &idl.Info{}}
+		prog, err := cfg.Parse(b)
+		if err != nil {
+			return prog, cfg.Info, err
+		}
+		cached = parsedFile{prog: prog, info: cfg.Info, hash: hash}
+	}
+
+	if fi != nil {
+		cached.modTime, cached.size = fi.ModTime(), fi.Size()
+	} else {
+		cached.modTime, cached.size = time.Time{}, 0
+	}
+
+	p.mu.Lock()
+	p.cache[filename] = cached
+	p.mu.Unlock()
+
+	return cached.prog, cached.info, nil
+}
+
+// Invalidate removes a file from the cache so that it will be parsed again the
+// next time it is requested.
+func (p *FileParser) Invalidate(filename string) {
+	filename, err := filepath.Abs(filename)
+	if err != nil {
+		return
 	}
 
-	prog, info, err := Parse(r)
-	if err == nil {
-		p.mu.Lock()
-		p.cache[filename] = parsedFile{prog: prog, info: info}
-		p.mu.Unlock()
+	p.mu.Lock()
+	delete(p.cache, filename)
+	p.mu.Unlock()
+}
+
+// SetOverlay supplies in-memory content for a file. ParseFile will use this
+// content instead of reading the file until the overlay is cleared.
+func (p *FileParser) SetOverlay(filename string, content []byte) {
+	filename, err := filepath.Abs(filename)
+	if err != nil {
+		return
 	}
 
-	return prog, info, err
+	p.mu.Lock()
+	p.overlays[filename] = content
+	p.mu.Unlock()
+}
+
+// ClearOverlay removes a file's overlay, if any, so that ParseFile will read
+// its content from disk again.
+func (p *FileParser) ClearOverlay(filename string) {
+	filename, err := filepath.Abs(filename)
+	if err != nil {
+		return
+	}
+
+	p.mu.Lock()
+	delete(p.overlays, filename)
+	p.mu.Unlock()
+}
+
+// Locate returns the absolute path of an included file. Relative paths are
+// resolved against dir (typically the including file's directory) and then
+// the parser's search directories.
+func (p *FileParser) Locate(path, dir string) (string, error) {
+	dirs := []string{""}
+	if !filepath.IsAbs(path) {
+		dirs = append([]string{dir}, p.dirs...)
+	}
+
+	for _, dir := range dirs {
+		candidate, err := filepath.Abs(filepath.Join(dir, path))
+		if err != nil {
+			continue
+		}
+
+		p.mu.RLock()
+		_, hasOverlay := p.overlays[candidate]
+		p.mu.RUnlock()
+		if hasOverlay {
+			return candidate, nil
+		}
+		if info, err := os.Stat(candidate); err == nil && !info.IsDir() {
+			return candidate, nil
+		}
+	}
+
+	return "", fmt.Errorf("%s not found", path)
 }
 
 // ParseFile parses a Thrift file from its filename.
 func (p *FileParser) ParseFile(filename string) (*ast.Program, *idl.Info, error) {
 	if filepath.IsAbs(filename) {
-		if f, err := os.Open(filename); err == nil {
-			defer f.Close()
-			return p.Parse(f, filename)
+		if prog, info, ok, err := p.parsePath(filename); ok {
+			return prog, info, err
 		}
 		return nil, nil, fmt.Errorf("%s not found", filename)
 	}
 
 	dirs := append([]string{filepath.Dir(filename)}, p.dirs...)
 	for _, dir := range dirs {
-		if f, err := os.Open(filepath.Join(dir, filename)); err == nil {
-			defer f.Close()
-			return p.Parse(f, f.Name())
+		if prog, info, ok, err := p.parsePath(filepath.Join(dir, filename)); ok {
+			return prog, info, err
 		}
 	}
 
 	return nil, nil, fmt.Errorf("%s not found in %s", filename, p.dirs)
 }
+
+// parsePath parses the file at path, preferring its overlay content, and
+// reports whether the file exists. The file is only read if it has been
+// modified since it was last parsed.
+func (p *FileParser) parsePath(path string) (*ast.Program, *idl.Info, bool, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, nil, false, nil
+	}
+
+	p.mu.RLock()
+	overlay, hasOverlay := p.overlays[path]
+	cached, hasCached := p.cache[path]
+	p.mu.RUnlock()
+
+	if hasOverlay {
+		prog, info, err := p.parse(overlay, path, nil)
+		return prog, info, true, err
+	}
+
+	fi, err := os.Stat(path)
+	if err != nil || fi.IsDir() {
+		return nil, nil, false, nil
+	}
+	if hasCached && !cached.modTime.IsZero() && cached.modTime.Equal(fi.ModTime()) && cached.size == fi.Size() {
+		return cached.prog, cached.info, true, nil
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, false, nil
+	}
+	prog, info, err := p.parse(b, path, fi)
+	return prog, info, true, err
+}
@@ -325,3 +325,158 @@ func TestFileParserParseFileCurrentDirectory(t *testing.T) {
 		t.Errorf("expected Struct1 from dir1, got %s", structDef.Name)
 	}
 }
+
+func TestFileParserInvalidate(t *testing.T) {
+	parser := NewFileParser(nil)
+
+	prog1, _, err := parser.Parse(strings.NewReader(testStructContent), "test.thrift")
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+
+	parser.Invalidate("test.thrift")
+	if len(parser.cache) != 0 {
+		t.Errorf("expected empty cache after invalidation, got %d entries", len(parser.cache))
+	}
+
+	prog2, _, err := parser.Parse(strings.NewReader(testStructContent), "test.thrift")
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	if prog1 == prog2 {
+		t.Error("expected a newly parsed program after invalidation")
+	}
+}
+
+func TestFileParserContentChanged(t *testing.T) {
+	parser := NewFileParser(nil)
+
+	prog1, _, err := parser.Parse(strings.NewReader(testStructContent), "test.thrift")
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+
+	prog2, _, err := parser.Parse(strings.NewReader(`struct Changed { 1: string field }`), "test.thrift")
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	if prog1 == prog2 {
+		t.Fatal("expected a newly parsed program after the content changed")
+	}
+	if name := prog2.Definitions[0].Info().Name; name != "Changed" {
+		t.Errorf("expected definition %q, got %q", "Changed", name)
+	}
+}
+
+func TestFileParserParseFileModified(t *testing.T) {
+	testFile := filepath.Join(t.TempDir(), "test.thrift")
+	if err := os.WriteFile(testFile, []byte(testStructContent), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	parser := NewFileParser(nil)
+	prog1, _, err := parser.ParseFile(testFile)
+	if err != nil {
+		t.Fatalf("ParseFile failed: %v", err)
+	}
+
+	prog2, _, err := parser.ParseFile(testFile)
+	if err != nil {
+		t.Fatalf("ParseFile failed: %v", err)
+	}
+	if prog1 != prog2 {
+		t.Error("expected cached program for an unmodified file")
+	}
+
+	if err := os.WriteFile(testFile, []byte(`struct Modified { 1: string field }`), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	prog3, _, err := parser.ParseFile(testFile)
+	if err != nil {
+		t.Fatalf("ParseFile failed: %v", err)
+	}
+	if name := prog3.Definitions[0].Info().Name; name != "Modified" {
+		t.Errorf("expected definition %q, got %q", "Modified", name)
+	}
+}
+
+func TestFileParserOverlay(t *testing.T) {
+	testFile := filepath.Join(t.TempDir(), "test.thrift")
+	if err := os.WriteFile(testFile, []byte(testStructContent), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	parser := NewFileParser(nil)
+	parser.SetOverlay(testFile, []byte(`struct Overlay { 1: string field }`))
+
+	prog, _, err := parser.ParseFile(testFile)
+	if err != nil {
+		t.Fatalf("ParseFile failed: %v", err)
+	}
+	if name := prog.Definitions[0].Info().Name; name != "Overlay" {
+		t.Errorf("expected definition %q, got %q", "Overlay", name)
+	}
+
+	parser.ClearOverlay(testFile)
+
+	prog, _, err = parser.ParseFile(testFile)
+	if err != nil {
+		t.Fatalf("ParseFile failed: %v", err)
+	}
+	if name := prog.Definitions[0].Info().Name; name != "TestStruct" {
+		t.Errorf("expected definition %q, got %q", "TestStruct", name)
+	}
+}
+
+func TestFileParserOverlayNotOnDisk(t *testing.T) {
+	testFile := filepath.Join(t.TempDir(), "unsaved.thrift")
+
+	parser := NewFileParser(nil)
+	parser.SetOverlay(testFile, []byte(testStructContent))
+
+	if _, _, err := parser.ParseFile(testFile); err != nil {
+		t.Fatalf("ParseFile failed: %v", err)
+	}
+}
+
+func TestFileParserLocate(t *testing.T) {
+	dir := t.TempDir()
+	includeDir := filepath.Join(dir, "include")
+	for _, path := range []string{
+		filepath.Join(dir, "local.thrift"),
+		filepath.Join(includeDir, "shared.thrift"),
+	} {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(testStructContent), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	parser := NewFileParser([]string{includeDir})
+	parser.SetOverlay(filepath.Join(dir, "unsaved.thrift"), []byte(testStructContent))
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"local.thrift", filepath.Join(dir, "local.thrift")},
+		{"shared.thrift", filepath.Join(includeDir, "shared.thrift")},
+		{"unsaved.thrift", filepath.Join(dir, "unsaved.thrift")},
+		{filepath.Join(includeDir, "shared.thrift"), filepath.Join(includeDir, "shared.thrift")},
+	}
+	for _, tt := range tests {
+		got, err := parser.Locate(tt.path, dir)
+		if err != nil {
+			t.Errorf("Locate(%q) failed: %v", tt.path, err)
+		} else if got != tt.want {
+			t.Errorf("Locate(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+
+	if _, err := parser.Locate("missing.thrift", dir); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2025 Pinterest
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thriftcheck
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"go.uber.org/thriftrw/ast"
+)
+
+// P is a type passed to all project check functions to provide context.
+//
+// Files are the absolute paths of the linted files, in the order they were
+// given. Graph contains those files along with all of the files that they
+// transitively include. Messages can be reported against any file in Graph.
+type P struct {
+	Files    []string
+	Graph    *IncludeGraph
+	Check    string
+	Messages Messages
+	logger   *log.Logger
+	parser   *FileParser
+	names    map[string]string
+}
+
+func newProject(filenames []string, parser *FileParser, logger *log.Logger) *P {
+	p := &P{
+		Graph:  NewIncludeGraph(parser, filenames),
+		logger: logger,
+		parser: parser,
+		names:  make(map[string]string, len(filenames)),
+	}
+	for _, filename := range filenames {
+		if path, err := filepath.Abs(filename); err == nil {
+			p.Files = append(p.Files, path)
+			p.names[path] = filename
+		}
+	}
+	return p
+}
+
+// Program returns the parsed program for a file, or nil if the file can't be
+// parsed.
+func (p *P) Program(filename string) *ast.Program {
+	program, _, err := p.parser.ParseFile(filename)
+	if err != nil {
+		return nil
+	}
+	return program
+}
+
+// Locate returns the absolute path of the file included by the given include
+// statement in filename, or false if it can't be found.
+func (p *P) Locate(filename string, include *ast.Include) (string, bool) {
+	path, err := p.parser.Locate(include.Path, filepath.Dir(filename))
+	return path, err == nil
+}
+
+// Logf prints a formatted message to the verbose output logger.
+func (p *P) Logf(message string, args ...any) {
+	if p.logger != nil {
+		p.logger.Printf(message, args...)
+	}
+}
+
+// Name returns the name used to report messages for a file. Linted files keep
+// the name they were given, and other files are named relative to the current
+// directory where possible.
+func (p *P) Name(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	if name, ok := p.names[path]; ok {
+		return name
+	}
+	if cwd, err := os.Getwd(); err == nil {
+		if rel, err := filepath.Rel(cwd, path); err == nil {
+			return rel
+		}
+	}
+	return path
+}
+
+func (p *P) pos(filename string, n ast.Node) ast.Position {
+	if _, info, err := p.parser.ParseFile(filename); err == nil {
+		return info.Pos(n)
+	}
+	pos, _ := ast.Pos(n)
+	return pos
+}
+
+func (p *P) reportf(filename string, node ast.Node, severity Severity, message string, args ...any) MessageRef {
+	m := Message{Filename: p.Name(filename), Pos: p.pos(filename, node), Node: node, Definition: definitionName([]ast.Node{node}), Check: p.Check, Severity: severity, Message: fmt.Sprintf(message, args...)}
+
+	// Messages for nodes covered by a 'nolint' directive are discarded.
+	if nolinted(p.Program(filename), node, p.Check) {
+		return MessageRef{msgs: &Messages{m}}
+	}
+
+	p.Messages = append(p.Messages, m)
+	return MessageRef{msgs: &p.Messages, i: len(p.Messages) - 1}
+}
+
+// Warningf records a new message for the given node in filename with Warning
+// severity.
+func (p *P) Warningf(filename string, node ast.Node, message string, args ...any) MessageRef {
+	return p.reportf(filename, node, Warning, message, args...)
+}
+
+// Errorf records a new message for the given node in filename with Error
+// severity.
+func (p *P) Errorf(filename string, node ast.Node, message string, args ...any) MessageRef {
+	return p.reportf(filename, node, Error, message, args...)
+}
+
+// InsertBefore returns an Edit that inserts text immediately before a node in
+// filename.
+func (p *P) InsertBefore(filename string, node ast.Node, text string) Edit {
+	pos := p.pos(filename, node)
+	return Edit{Pos: pos, End: pos, Text: text}
+}
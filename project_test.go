@@ -0,0 +1,88 @@
+// Copyright 2025 Pinterest
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thriftcheck
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/thriftrw/ast"
+)
+
+func TestLintFilesProject(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"a.thrift":     "include \"b.thrift\"\nstruct A {}\n",
+		"b.thrift":     "struct B {}\n\nstruct C {} (nolint = \"project\")\n",
+		"inc/c.thrift": "struct D {}\n",
+	})
+	a := filepath.Join(dir, "a.thrift")
+
+	checks := Checks{
+		NewCheck("struct", func(c *C, s *ast.Struct) { c.Warningf(s, "%s", s.Name) }),
+		NewProjectCheck("project", func(p *P) {
+			for _, filename := range p.Graph.Files() {
+				for _, def := range p.Program(filename).Definitions {
+					p.Errorf(filename, def, "%s", def.Info().Name)
+				}
+			}
+		}),
+	}
+
+	msgs, err := NewLinter(checks).LintFiles([]string{a})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := filepath.Rel(cwd, filepath.Join(dir, "b.thrift"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		a + ":2:1: warning: A (struct)",
+		a + ":2:1: error: A (project)",
+		b + ":1:1: error: B (project)",
+	}
+	if len(msgs) != len(want) {
+		t.Fatalf("expected %d messages, got %d: %v", len(want), len(msgs), msgs)
+	}
+	for i, m := range msgs {
+		if m.String() != want[i] {
+			t.Errorf("message %d: expected %q, got %q", i, want[i], m.String())
+		}
+	}
+}
+
+func TestLintFilesWithoutProjectChecks(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{"a.thrift": "struct A {}\n"})
+
+	checks := Checks{
+		NewCheck("struct", func(c *C, s *ast.Struct) { c.Warningf(s, "%s", s.Name) }),
+	}
+	msgs, err := NewLinter(checks).LintFiles([]string{filepath.Join(dir, "a.thrift")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(msgs) != 1 {
+		t.Errorf("expected 1 message, got %d: %v", len(msgs), msgs)
+	}
+}
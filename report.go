@@ -0,0 +1,88 @@
+// Copyright 2025 Pinterest
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thriftcheck
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+
+	"go.uber.org/thriftrw/ast"
+)
+
+// Reporter writes Messages to an io.Writer using a particular output format.
+type Reporter interface {
+	Report(w io.Writer, msgs Messages) error
+}
+
+// TextReporter is a Reporter that writes one Message per line using the
+// familiar "file:line:col: severity: message (check)" format.
+type TextReporter struct{}
+
+// Report writes msgs to w.
+func (TextReporter) Report(w io.Writer, msgs Messages) error {
+	for _, m := range msgs {
+		if _, err := fmt.Fprintln(w, m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JSONReporter is a Reporter that writes Messages as a JSON array of records.
+type JSONReporter struct{}
+
+type jsonMessage struct {
+	Filename string `json:"filename"`
+	Line     int    `json:"line"`
+	Column   int    `json:"column"`
+	Severity string `json:"severity"`
+	Check    string `json:"check"`
+	Message  string `json:"message"`
+	Node     string `json:"node,omitempty"`
+}
+
+// Report writes msgs to w.
+func (JSONReporter) Report(w io.Writer, msgs Messages) error {
+	records := make([]jsonMessage, len(msgs))
+	for i, m := range msgs {
+		records[i] = jsonMessage{
+			Filename: m.Filename,
+			Line:     m.Pos.Line,
+			Column:   m.column(),
+			Severity: m.Severity.String(),
+			Check:    m.Check,
+			Message:  m.Message,
+			Node:     nodeKind(m.Node),
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(records)
+}
+
+// nodeKind returns the name of an ast.Node's type (e.g. "Field").
+func nodeKind(n ast.Node) string {
+	t := reflect.TypeOf(n)
+	if t == nil {
+		return ""
+	}
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Name()
+}
@@ -0,0 +1,97 @@
+// Copyright 2025 Pinterest
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thriftcheck
+
+import (
+	"bytes"
+	"testing"
+
+	"go.uber.org/thriftrw/ast"
+)
+
+var testMessages = Messages{
+	{Filename: "a.thrift", Pos: ast.Position{Line: 5}, Node: &ast.Field{}, Check: "field.check", Severity: Warning, Message: "Warning"},
+	{Filename: "b.thrift", Pos: ast.Position{Line: 2, Column: 3}, Node: ast.BaseType{}, Check: "type.check", Severity: Error, Message: "Error"},
+	{Filename: "b.thrift", Pos: ast.Position{Line: 1, Column: 1}, Check: "parse", Severity: Error, Message: "syntax error"},
+}
+
+func TestTextReporter(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (TextReporter{}).Report(&buf, testMessages); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := `a.thrift:5:1: warning: Warning (field.check)
+b.thrift:2:3: error: Error (type.check)
+b.thrift:1:1: error: syntax error (parse)
+`
+	if got := buf.String(); got != want {
+		t.Errorf("expected:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+func TestJSONReporter(t *testing.T) {
+	tests := []struct {
+		msgs Messages
+		want string
+	}{
+		{
+			msgs: nil,
+			want: "[]\n",
+		},
+		{
+			msgs: testMessages,
+			want: `[
+  {
+    "filename": "a.thrift",
+    "line": 5,
+    "column": 1,
+    "severity": "warning",
+    "check": "field.check",
+    "message": "Warning",
+    "node": "Field"
+  },
+  {
+    "filename": "b.thrift",
+    "line": 2,
+    "column": 3,
+    "severity": "error",
+    "check": "type.check",
+    "message": "Error",
+    "node": "BaseType"
+  },
+  {
+    "filename": "b.thrift",
+    "line": 1,
+    "column": 1,
+    "severity": "error",
+    "check": "parse",
+    "message": "syntax error"
+  }
+]
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		if err := (JSONReporter{}).Report(&buf, tt.msgs); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := buf.String(); got != tt.want {
+			t.Errorf("expected:\n%s\ngot:\n%s", tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright 2025 Pinterest
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thriftcheck
+
+import (
+	"encoding/json"
+	"io"
+	"net/url"
+	"path/filepath"
+)
+
+// SARIFReporter is a Reporter that writes Messages as a SARIF 2.1.0 log.
+//
+// Each of the given Checks is described by a SARIF rule. A rule's default
+// level is the most severe level it reported, or "warning" if it reported
+// nothing.
+type SARIFReporter struct {
+	Checks  Checks
+	Version string
+}
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Version        string      `json:"version,omitempty"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string             `json:"id"`
+	ShortDescription     *sarifText         `json:"shortDescription,omitempty"`
+	DefaultConfiguration sarifConfiguration `json:"defaultConfiguration"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifText struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	RuleIndex int             `json:"ruleIndex"`
+	Level     string          `json:"level"`
+	Message   sarifText       `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn"`
+}
+
+// Report writes msgs to w.
+func (r SARIFReporter) Report(w io.Writer, msgs Messages) error {
+	driver := sarifDriver{
+		Name:           "thriftcheck",
+		InformationURI: "https://github.com/pinterest/thriftcheck",
+		Version:        r.Version,
+		Rules:          []sarifRule{},
+	}
+
+	rules := make(map[string]int, len(r.Checks))
+	addRule := func(name, description string) int {
+		if i, ok := rules[name]; ok {
+			return i
+		}
+		rule := sarifRule{ID: name, DefaultConfiguration: sarifConfiguration{Level: sarifLevel(Warning)}}
+		if description != "" {
+			rule.ShortDescription = &sarifText{Text: description}
+		}
+		rules[name] = len(driver.Rules)
+		driver.Rules = append(driver.Rules, rule)
+		return rules[name]
+	}
+
+	byName := make(map[string]Check, len(r.Checks))
+	for _, check := range r.Checks {
+		byName[check.Name] = check
+	}
+	for _, name := range r.Checks.SortedNames() {
+		addRule(name, byName[name].Description)
+	}
+
+	results := make([]sarifResult, len(msgs))
+	for i, m := range msgs {
+		index := addRule(m.Check, "")
+		if m.Severity == Error {
+			driver.Rules[index].DefaultConfiguration.Level = sarifLevel(Error)
+		}
+
+		location := sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: sarifURI(m.Filename)}}
+		if m.Pos.Line > 0 {
+			location.Region = &sarifRegion{StartLine: m.Pos.Line, StartColumn: m.column()}
+		}
+
+		results[i] = sarifResult{
+			RuleID:    m.Check,
+			RuleIndex: index,
+			Level:     sarifLevel(m.Severity),
+			Message:   sarifText{Text: m.Message},
+			Locations: []sarifLocation{{PhysicalLocation: location}},
+		}
+	}
+
+	log := sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{{Tool: sarifTool{Driver: driver}, Results: results}},
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(log)
+}
+
+func sarifLevel(s Severity) string {
+	if s == Error {
+		return "error"
+	}
+	return "warning"
+}
+
+// sarifURI converts a filename to a URI reference. Relative filenames remain
+// relative so they can be resolved against the repository root.
+func sarifURI(filename string) string {
+	u := url.URL{Path: filepath.ToSlash(filename)}
+	if filepath.IsAbs(filename) {
+		u.Scheme = "file"
+	}
+	return u.String()
+}
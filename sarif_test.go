@@ -0,0 +1,105 @@
+// Copyright 2025 Pinterest
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thriftcheck
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"go.uber.org/thriftrw/ast"
+)
+
+func TestSARIFReporter(t *testing.T) {
+	checks := Checks{
+		NewCheck("type.check", func(c *C, n ast.Node) {}),
+		NewCheck("field.check", func(c *C, f *ast.Field) {}).WithDescription("Fields are checked"),
+		NewCheck("unused.check", func(c *C, n ast.Node) {}),
+	}
+
+	var buf bytes.Buffer
+	reporter := SARIFReporter{Checks: checks, Version: "1.2.3"}
+	if err := reporter.Report(&buf, testMessages); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var log sarifLog
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, buf.String())
+	}
+
+	if log.Version != "2.1.0" || len(log.Runs) != 1 {
+		t.Fatalf("unexpected log: %+v", log)
+	}
+	driver := log.Runs[0].Tool.Driver
+	if driver.Name != "thriftcheck" || driver.Version != "1.2.3" {
+		t.Errorf("unexpected driver: %+v", driver)
+	}
+
+	wantRules := []sarifRule{
+		{ID: "field.check", ShortDescription: &sarifText{Text: "Fields are checked"}, DefaultConfiguration: sarifConfiguration{Level: "warning"}},
+		{ID: "type.check", DefaultConfiguration: sarifConfiguration{Level: "error"}},
+		{ID: "unused.check", DefaultConfiguration: sarifConfiguration{Level: "warning"}},
+		{ID: "parse", DefaultConfiguration: sarifConfiguration{Level: "error"}},
+	}
+	if !reflect.DeepEqual(driver.Rules, wantRules) {
+		t.Errorf("expected rules:\n%+v\ngot:\n%+v", wantRules, driver.Rules)
+	}
+
+	wantResults := []sarifResult{
+		{
+			RuleID: "field.check", RuleIndex: 0, Level: "warning", Message: sarifText{Text: "Warning"},
+			Locations: []sarifLocation{{PhysicalLocation: sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: "a.thrift"},
+				Region:           &sarifRegion{StartLine: 5, StartColumn: 1},
+			}}},
+		},
+		{
+			RuleID: "type.check", RuleIndex: 1, Level: "error", Message: sarifText{Text: "Error"},
+			Locations: []sarifLocation{{PhysicalLocation: sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: "b.thrift"},
+				Region:           &sarifRegion{StartLine: 2, StartColumn: 3},
+			}}},
+		},
+		{
+			RuleID: "parse", RuleIndex: 3, Level: "error", Message: sarifText{Text: "syntax error"},
+			Locations: []sarifLocation{{PhysicalLocation: sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: "b.thrift"},
+				Region:           &sarifRegion{StartLine: 1, StartColumn: 1},
+			}}},
+		},
+	}
+	if !reflect.DeepEqual(log.Runs[0].Results, wantResults) {
+		t.Errorf("expected results:\n%+v\ngot:\n%+v", wantResults, log.Runs[0].Results)
+	}
+}
+
+func TestSARIFURI(t *testing.T) {
+	tests := []struct {
+		filename string
+		want     string
+	}{
+		{"a.thrift", "a.thrift"},
+		{"dir/a b.thrift", "dir/a%20b.thrift"},
+		{"/abs/a.thrift", "file:///abs/a.thrift"},
+	}
+
+	for _, tt := range tests {
+		if got := sarifURI(tt.filename); got != tt.want {
+			t.Errorf("%s: expected %q, got %q", tt.filename, tt.want, got)
+		}
+	}
+}